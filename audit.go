@@ -0,0 +1,86 @@
+package bimap
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// AuditOp identifies the kind of change recorded in an AuditEntry.
+type AuditOp int
+
+const (
+	// AuditInsert records a new key being added.
+	AuditInsert AuditOp = iota
+	// AuditUpdate records an existing key being mapped to a new value.
+	AuditUpdate
+	// AuditDelete records a key being removed.
+	AuditDelete
+)
+
+// String returns the name of the operation.
+func (op AuditOp) String() string {
+	switch op {
+	case AuditInsert:
+		return "insert"
+	case AuditUpdate:
+		return "update"
+	case AuditDelete:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// AuditEntry records who changed a key, when, and how.
+type AuditEntry[K comparable, V comparable] struct {
+	Op        AuditOp
+	Key       K
+	OldValue  V
+	NewValue  V
+	Actor     string
+	RequestID string
+	At        time.Time
+}
+
+type actorKey struct{}
+
+type requestIDKey struct{}
+
+// ContextWithActor returns a copy of ctx carrying the actor that ctx-accepting mutations are attributed to.
+func ContextWithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ContextWithRequestID returns a copy of ctx carrying the request ID that ctx-accepting mutations are attributed to.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// auditLog holds the audit entries of a BiMap built with WithAuditLog, grouped by key.
+// It is only written under the BiMap's write lock but has its own mutex so it can be read concurrently.
+type auditLog[K comparable, V comparable] struct {
+	mu      sync.Mutex
+	entries map[K][]AuditEntry[K, V]
+}
+
+func (a *auditLog[K, V]) record(ctx context.Context, e AuditEntry[K, V]) {
+	e.Actor, _ = ctx.Value(actorKey{}).(string)
+	e.RequestID, _ = ctx.Value(requestIDKey{}).(string)
+	e.At = time.Now()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.entries[e.Key] = append(a.entries[e.Key], e)
+}
+
+// AuditLog returns the recorded changes to k, oldest first. It returns nil unless the BiMap was built with WithAuditLog.
+func (b *BiMap[K, V]) AuditLog(k K) []AuditEntry[K, V] {
+	if b.audit == nil {
+		return nil
+	}
+	b.rlock()
+	k = b.resolveKey(k)
+	b.runlock()
+	b.audit.mu.Lock()
+	defer b.audit.mu.Unlock()
+	return append([]AuditEntry[K, V](nil), b.audit.entries[k]...)
+}
@@ -0,0 +1,54 @@
+package bimap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithAuditLog(t *testing.T) {
+	actual := NewBiMap[string, int](WithAuditLog())
+	ctx := ContextWithRequestID(ContextWithActor(context.Background(), "alice"), "req-1")
+
+	actual.InsertCtx(ctx, "a", 1)
+	actual.Insert("a", 2)
+	actual.DeleteByValueCtx(ContextWithActor(context.Background(), "bob"), 2)
+	actual.InsertCtx(ctx, "b", 3)
+	actual.DeleteByKeyCtx(ctx, "b")
+
+	log := actual.AuditLog("a")
+	assert.Len(t, log, 3)
+	assert.Equal(t, AuditInsert, log[0].Op)
+	assert.Equal(t, "alice", log[0].Actor)
+	assert.Equal(t, "req-1", log[0].RequestID)
+	assert.Equal(t, 1, log[0].NewValue)
+	assert.False(t, log[0].At.IsZero())
+
+	assert.Equal(t, AuditUpdate, log[1].Op)
+	assert.Equal(t, "", log[1].Actor, "Mutations without a context should be unattributed")
+	assert.Equal(t, 1, log[1].OldValue)
+	assert.Equal(t, 2, log[1].NewValue)
+
+	assert.Equal(t, AuditDelete, log[2].Op)
+	assert.Equal(t, "bob", log[2].Actor)
+	assert.Equal(t, 2, log[2].OldValue)
+
+	assert.Len(t, actual.AuditLog("b"), 2)
+	assert.Empty(t, actual.AuditLog("missing"))
+}
+
+func TestAuditLog_Disabled(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	actual.InsertCtx(ContextWithActor(context.Background(), "alice"), "a", 1)
+
+	assert.Nil(t, actual.AuditLog("a"))
+	v, _ := actual.GetByKey("a")
+	assert.Equal(t, 1, v, "Ctx variants should behave like their plain counterparts")
+}
+
+func TestAuditOp_String(t *testing.T) {
+	assert.Equal(t, "insert", AuditInsert.String())
+	assert.Equal(t, "update", AuditUpdate.String())
+	assert.Equal(t, "delete", AuditDelete.String())
+}
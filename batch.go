@@ -0,0 +1,146 @@
+package bimap
+
+import (
+	"context"
+	"fmt"
+)
+
+// InsertAll puts every pair of m into the BiMap as Insert would, acquiring the lock once for the whole batch.
+// If an entry is rejected by a validator or the size limit, InsertAll panics and the entries stored before it remain.
+func (b *BiMap[K, V]) InsertAll(m map[K]V) {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		panic("Cannot modify immutable map")
+	}
+	for k, v := range m {
+		if err := b.insertLocked(context.Background(), k, v); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// InsertPairs is like InsertAll but inserts pairs in order, so a later pair for the same key wins.
+func (b *BiMap[K, V]) InsertPairs(pairs []Pair[K, V]) {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		panic("Cannot modify immutable map")
+	}
+	for _, p := range pairs {
+		if err := b.insertLocked(context.Background(), p.Key, p.Value); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// DeleteKeys removes the pairs for the given keys, acquiring the lock once, and returns how many were removed.
+func (b *BiMap[K, V]) DeleteKeys(keys ...K) int {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		panic("Cannot modify immutable map")
+	}
+	removed := 0
+	for _, k := range keys {
+		k = b.resolveKey(k)
+		if v, ok := b.forward[k]; ok {
+			b.deletePair(context.Background(), k, v)
+			removed++
+		}
+	}
+	return removed
+}
+
+// DeleteValues removes the pairs for the given values, acquiring the lock once, and returns how many were removed.
+func (b *BiMap[K, V]) DeleteValues(values ...V) int {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		panic("Cannot modify immutable map")
+	}
+	removed := 0
+	for _, v := range values {
+		v = b.resolveValue(v)
+		if k, ok := b.inverse[v]; ok {
+			b.deletePair(context.Background(), k, v)
+			removed++
+		}
+	}
+	return removed
+}
+
+// DeleteWhere removes every pair for which pred returns true, acquiring the lock once, and returns how many were
+// removed. pred runs under the write lock and must not call methods on the BiMap.
+func (b *BiMap[K, V]) DeleteWhere(pred func(k K, v V) bool) int {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		panic("Cannot modify immutable map")
+	}
+	removed := 0
+	for k, v := range b.forward {
+		if pred(k, v) {
+			b.deletePair(context.Background(), k, v)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Merge folds other's pairs into the BiMap under a single lock acquisition. For keys present in both, resolve picks
+// the value to keep from the existing and incoming ones; a nil resolve keeps the incoming value. The merge is all or
+// nothing: if the result would give one value to two keys it returns an error wrapping ErrDuplicateValue and leaves
+// the BiMap unchanged, as it does when a validator, the size limit or immutability (ErrImmutable) rejects it.
+func (b *BiMap[K, V]) Merge(other *BiMap[K, V], resolve func(k K, existing, incoming V) V) error {
+	// Snapshot other before locking b, so that merging a BiMap into itself cannot deadlock.
+	incoming := other.SnapshotForward()
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return ErrImmutable
+	}
+	plan := make(map[K]V, len(incoming))
+	added := 0
+	for k, v := range incoming {
+		k, v = b.resolveKey(k), b.resolveValue(v)
+		existing, ok := plan[k]
+		if !ok {
+			if existing, ok = b.forward[k]; !ok {
+				added++
+			}
+		}
+		if ok && resolve != nil {
+			v = b.resolveValue(resolve(k, existing, v))
+		}
+		if err := b.validatePair(k, v); err != nil {
+			return err
+		}
+		plan[k] = v
+	}
+	if b.maxEntries > 0 && len(b.forward)+added > b.maxEntries {
+		return fmt.Errorf("%w: merging %d new keys would exceed the limit of %d entries", ErrQuotaExceeded, added, b.maxEntries)
+	}
+	holders := make(map[V]K, len(plan))
+	for k, v := range plan {
+		if other, ok := holders[v]; ok {
+			return fmt.Errorf("%w: %v held by both %v and %v", ErrDuplicateValue, v, other, k)
+		}
+		holders[v] = k
+		if owner, ok := b.inverse[v]; ok && owner != k {
+			// The value is only free if its current owner is moving to another value.
+			if _, moving := plan[owner]; !moving {
+				return fmt.Errorf("%w: %v held by both %v and %v", ErrDuplicateValue, v, owner, k)
+			}
+		}
+	}
+	for k, v := range plan {
+		if current, ok := b.forward[k]; ok && current == v {
+			continue
+		}
+		if err := b.insertLocked(context.Background(), k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
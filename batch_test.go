@@ -0,0 +1,112 @@
+package bimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap_InsertAll(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	actual.InsertAll(map[string]int{"a": 1, "b": 2})
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, actual.SnapshotForward())
+	assert.Equal(t, map[int]string{1: "a", 2: "b"}, actual.SnapshotInverse())
+}
+
+func TestBiMap_InsertAllRejected(t *testing.T) {
+	actual := NewBiMap[string, int](WithMaxEntries(1))
+
+	assert.Panics(t, func() { actual.InsertAll(map[string]int{"a": 1, "b": 2}) })
+	assert.Equal(t, 1, actual.Size(), "Entries stored before the rejected one should remain")
+}
+
+func TestBiMap_InsertPairs(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	actual.InsertPairs([]Pair[string, int]{{"a", 1}, {"b", 2}, {"a", 3}})
+
+	assert.Equal(t, map[string]int{"a": 3, "b": 2}, actual.SnapshotForward())
+	assert.Equal(t, map[int]string{3: "a", 2: "b"}, actual.SnapshotInverse())
+}
+
+func TestBiMap_DeleteKeys(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	assert.Equal(t, 2, actual.DeleteKeys("a", "c", "missing"))
+	assert.Equal(t, map[string]int{"b": 2}, actual.SnapshotForward())
+	assert.Equal(t, map[int]string{2: "b"}, actual.SnapshotInverse())
+}
+
+func TestBiMap_DeleteValues(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	assert.Equal(t, 2, actual.DeleteValues(1, 2, 99))
+	assert.Equal(t, map[string]int{"c": 3}, actual.SnapshotForward())
+	assert.Equal(t, map[int]string{3: "c"}, actual.SnapshotInverse())
+}
+
+func TestBiMap_DeleteWhere(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3, "d": 4})
+
+	assert.Equal(t, 2, actual.DeleteWhere(func(k string, v int) bool { return v%2 == 0 }))
+	assert.Equal(t, map[string]int{"a": 1, "c": 3}, actual.SnapshotForward())
+	assert.Equal(t, map[int]string{1: "a", 3: "c"}, actual.SnapshotInverse())
+	assert.Equal(t, 0, actual.DeleteWhere(func(string, int) bool { return false }))
+}
+
+func TestBiMap_BatchImmutable(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	actual.MakeImmutable()
+
+	assert.Panics(t, func() { actual.InsertAll(map[string]int{"a": 1}) })
+	assert.Panics(t, func() { actual.InsertPairs([]Pair[string, int]{{"a", 1}}) })
+	assert.Panics(t, func() { actual.DeleteKeys("a") })
+	assert.Panics(t, func() { actual.DeleteValues(1) })
+	assert.Panics(t, func() { actual.DeleteWhere(func(string, int) bool { return true }) })
+}
+
+func TestBiMap_Merge(t *testing.T) {
+	base := NewBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	overrides := NewBiMapFromMap(map[string]int{"a": 10, "d": 4})
+
+	var conflicts []string
+	err := base.Merge(overrides, func(k string, existing, incoming int) int {
+		conflicts = append(conflicts, k)
+		return existing + incoming
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, conflicts, "The resolver should only see keys present in both")
+	assert.Equal(t, map[string]int{"a": 11, "b": 2, "c": 3, "d": 4}, base.SnapshotForward())
+	assert.NoError(t, base.Validate())
+
+	assert.NoError(t, base.Merge(NewBiMapFromMap(map[string]int{"b": 5}), nil))
+	assert.Equal(t, 5, base.GetByKeyWithFallback("b", 0), "A nil resolver should keep the incoming value")
+}
+
+func TestBiMap_Merge_Swap(t *testing.T) {
+	base := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	assert.NoError(t, base.Merge(NewBiMapFromMap(map[string]int{"a": 2, "b": 1}), nil))
+	assert.Equal(t, map[string]int{"a": 2, "b": 1}, base.SnapshotForward(), "Values freed within the merge should be reusable")
+	assert.NoError(t, base.Validate())
+}
+
+func TestBiMap_Merge_Conflicts(t *testing.T) {
+	base := NewBiMapFromMap(map[string]int{"a": 1, "b": 2}, WithMaxEntries(4))
+
+	err := base.Merge(NewBiMapFromMap(map[string]int{"c": 3, "d": 1}), nil)
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, base.SnapshotForward(), "Failed merges should change nothing")
+
+	err = base.Merge(NewBiMapFromMap(map[string]int{"a": 5}), func(string, int, int) int { return 2 })
+	assert.ErrorIs(t, err, ErrDuplicateValue, "Resolved values should be checked too")
+
+	err = base.Merge(NewBiMapFromMap(map[string]int{"c": 3, "d": 4, "e": 5}), nil)
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+	assert.Equal(t, 2, base.Size())
+
+	assert.NoError(t, base.Merge(base, nil), "Merging a BiMap into itself should be a no-op")
+
+	base.MakeImmutable()
+	assert.ErrorIs(t, base.Merge(NewBiMap[string, int](), nil), ErrImmutable)
+}
@@ -1,7 +1,17 @@
 // Package bimap provides a threadsafe bidirectional map
 package bimap
 
-import "sync"
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
 
 // BiMap is a bi-directional hashmap that is thread safe and supports immutability
 type BiMap[K comparable, V comparable] struct {
@@ -9,42 +19,321 @@ type BiMap[K comparable, V comparable] struct {
 	immutable bool
 	forward   map[K]V
 	inverse   map[V]K
+	fold      func(K) string
+	folded    map[string]K
+	normKey   func(K) K
+	normValue func(V) V
+
+	internKey   func(K) K
+	internValue func(V) V
+
+	contention *contention
+	lookups    *lookups
+	tombstones map[K]tombstone[V]
+	audit      *auditLog[K, V]
+	maxEntries int
+
+	validateKey   func(K) error
+	validateValue func(V) error
+
+	lastMutation *time.Time
+	noLocking    bool
+	locker       RWLocker
+	hooks        *hooks[K, V]
+	metrics      Collector
+	waiters      map[K][]chan V
 }
 
 // NewBiMap returns a an empty, mutable, biMap
-func NewBiMap[K comparable, V comparable]() *BiMap[K, V] {
-	return &BiMap[K, V]{forward: make(map[K]V), inverse: make(map[V]K), immutable: false}
+func NewBiMap[K comparable, V comparable](opts ...Option) *BiMap[K, V] {
+	b := &BiMap[K, V]{forward: make(map[K]V), inverse: make(map[V]K), immutable: false}
+	applyOptions(b, opts)
+	return b
 }
 
-// NewBiMapFromMap returns a new BiMap from a map[K, V]
-func NewBiMapFromMap[K comparable, V comparable](forwardMap map[K]V) *BiMap[K, V] {
-	biMap := NewBiMap[K, V]()
-	for k, v := range forwardMap {
-		biMap.Insert(k, v)
+// NewBiMapWithCapacity returns an empty, mutable BiMap with both directions pre-sized for n entries,
+// avoiding rehashes while building large tables.
+func NewBiMapWithCapacity[K comparable, V comparable](n int, opts ...Option) *BiMap[K, V] {
+	b := &BiMap[K, V]{forward: make(map[K]V, n), inverse: make(map[V]K, n)}
+	applyOptions(b, opts)
+	if b.folded != nil {
+		b.folded = make(map[string]K, n)
 	}
+	return b
+}
+
+// NewBiMapFromBiMap returns an independent, mutable deep copy of src. See Clone.
+func NewBiMapFromBiMap[K comparable, V comparable](src *BiMap[K, V]) *BiMap[K, V] {
+	return src.Clone()
+}
+
+// NewUnsafeBiMap returns an empty, mutable BiMap that does no locking, for single-goroutine hot paths.
+// It has the same API as a BiMap built with NewBiMap, but it is not safe for concurrent use if any goroutine modifies it.
+// It is equivalent to NewBiMap with the WithoutLocking option.
+func NewUnsafeBiMap[K comparable, V comparable](opts ...Option) *BiMap[K, V] {
+	return NewBiMap[K, V](append(opts, WithoutLocking())...)
+}
+
+// NewBiMapFromMap returns a new BiMap from a map[K, V]
+func NewBiMapFromMap[K comparable, V comparable](forwardMap map[K]V, opts ...Option) *BiMap[K, V] {
+	biMap := NewBiMapWithCapacity[K, V](len(forwardMap), opts...)
+	biMap.InsertAll(forwardMap)
 	return biMap
 }
 
+// NewBiMapFromSlices returns a new BiMap pairing keys[i] with values[i]. It returns ErrLengthMismatch if the slices
+// differ in length, and ErrDuplicateKey or ErrDuplicateValue if they do not describe a bijection.
+func NewBiMapFromSlices[K comparable, V comparable](keys []K, values []V, opts ...Option) (*BiMap[K, V], error) {
+	if len(keys) != len(values) {
+		return nil, fmt.Errorf("%w: %d keys, %d values", ErrLengthMismatch, len(keys), len(values))
+	}
+	biMap := NewBiMapWithCapacity[K, V](len(keys), opts...)
+	for i, k := range keys {
+		if err := biMap.InsertStrict(k, values[i]); err != nil {
+			return nil, err
+		}
+	}
+	return biMap, nil
+}
+
 // Insert puts a key and value into the BiMap, provided its mutable. Also creates the reverse mapping from value to key.
-func (b *BiMap[K, V]) Insert(k K, v V) {
-	b.s.Lock()
-	defer b.s.Unlock()
+func (b *BiMap[K, V]) Insert(k K, v V) { b.InsertCtx(context.Background(), k, v) }
+
+// InsertCtx is like Insert, attributing the change to the actor and request ID carried by ctx in the audit log.
+func (b *BiMap[K, V]) InsertCtx(ctx context.Context, k K, v V) {
+	if err := b.insert(ctx, k, v); err != nil {
+		panic(err)
+	}
+}
+
+// TryInsert is like Insert but returns an error instead of panicking when the entry is rejected
+// by a validator, the BiMap is full, or the BiMap is immutable (ErrImmutable).
+func (b *BiMap[K, V]) TryInsert(k K, v V) error {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return ErrImmutable
+	}
+	return b.insertLocked(context.Background(), k, v)
+}
+
+// Put is like TryInsert, following Guava's BiMap.put: it replaces the value of an existing key, but returns
+// ErrDuplicateValue if v is held by another key, leaving the BiMap unchanged.
+func (b *BiMap[K, V]) Put(k K, v V) error {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return ErrImmutable
+	}
+	rk, rv := b.resolveKey(k), b.resolveValue(v)
+	if owner, ok := b.inverse[rv]; ok && owner != rk {
+		return fmt.Errorf("%w: %v already held by %v", ErrDuplicateValue, rv, owner)
+	}
+	return b.insertLocked(context.Background(), k, v)
+}
+
+// ForcePut follows Guava's BiMap.forcePut: it removes both the previous pairing of k and the previous pairing of v
+// before inserting, so the BiMap always stays a bijection. Like Insert, it panics if the BiMap is immutable or the
+// pair is rejected. Use InsertReturning to learn what was removed.
+func (b *BiMap[K, V]) ForcePut(k K, v V) {
+	if _, err := b.InsertReturning(k, v); err != nil {
+		if errors.Is(err, ErrImmutable) {
+			panic("Cannot modify immutable map")
+		}
+		panic(err)
+	}
+}
+
+// Displaced describes the pairings an InsertReturning call broke.
+type Displaced[K comparable, V comparable] struct {
+	// Value is the value the inserted key held before, if Replaced is true.
+	Value    V
+	Replaced bool
+	// Key is the key that held the inserted value before and was removed, if Unlinked is true.
+	Key      K
+	Unlinked bool
+}
+
+// InsertReturning is like TryInsert, but if v was held by another key that key is removed, so the BiMap stays a
+// bijection, and the returned Displaced reports both the key's previous value and the value's previous key.
+func (b *BiMap[K, V]) InsertReturning(k K, v V) (Displaced[K, V], error) {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return Displaced[K, V]{}, ErrImmutable
+	}
+	return b.insertReturningLocked(k, v)
+}
+
+// insertReturningLocked implements InsertReturning. The caller must hold the write lock.
+func (b *BiMap[K, V]) insertReturningLocked(k K, v V) (Displaced[K, V], error) {
+	var d Displaced[K, V]
+	rk, rv := b.resolveKey(k), b.resolveValue(v)
+	if err := b.validatePair(rk, rv); err != nil {
+		return d, err
+	}
+	d.Value, d.Replaced = b.forward[rk]
+	if owner, ok := b.inverse[rv]; ok && owner != rk {
+		d.Key, d.Unlinked = owner, true
+		b.deletePair(context.Background(), owner, rv)
+	}
+	return d, b.insertLocked(context.Background(), k, v)
+}
+
+// InsertStrict is like TryInsert but never breaks an existing pair: it returns ErrDuplicateKey if k is already
+// present or ErrDuplicateValue if v is already held by another key, leaving the BiMap unchanged. It returns
+// ErrImmutable if the BiMap is immutable.
+func (b *BiMap[K, V]) InsertStrict(k K, v V) error {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return ErrImmutable
+	}
+	rk, rv := b.resolveKey(k), b.resolveValue(v)
+	if _, ok := b.forward[rk]; ok {
+		return fmt.Errorf("%w: %v", ErrDuplicateKey, rk)
+	}
+	if other, ok := b.inverse[rv]; ok {
+		return fmt.Errorf("%w: %v already held by %v", ErrDuplicateValue, rv, other)
+	}
+	return b.insertResolved(context.Background(), rk, rv)
+}
+
+// GetOrInsert returns the existing value for k if present. Otherwise it inserts v as Insert would and returns it.
+// The loaded result is true if the value was loaded, false if inserted. The check and insert happen atomically.
+func (b *BiMap[K, V]) GetOrInsert(k K, v V) (actual V, loaded bool) {
+	b.lock()
+	defer b.unlock()
 	if b.immutable {
 		panic("Cannot modify immutable map")
 	}
-	if _, ok := b.forward[k]; ok {
-		delete(b.inverse, b.forward[k])
+	k = b.resolveKey(k)
+	if existing, ok := b.forward[k]; ok {
+		return existing, true
+	}
+	if err := b.insertResolved(context.Background(), k, b.resolveValue(v)); err != nil {
+		panic(err)
+	}
+	return b.forward[k], false
+}
+
+// Upsert stores fn(old, exists) for k, where old is k's current value and exists reports whether k was present.
+// fn runs under the write lock, so the read and update are atomic; it must not call methods on the BiMap.
+// The new value is stored as Insert would.
+func (b *BiMap[K, V]) Upsert(k K, fn func(old V, exists bool) V) {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		panic("Cannot modify immutable map")
+	}
+	k = b.resolveKey(k)
+	old, exists := b.forward[k]
+	if err := b.insertResolved(context.Background(), k, b.resolveValue(fn(old, exists))); err != nil {
+		panic(err)
+	}
+}
+
+func (b *BiMap[K, V]) insert(ctx context.Context, k K, v V) error {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		panic("Cannot modify immutable map")
+	}
+	return b.insertLocked(ctx, k, v)
+}
+
+// insertLocked normalizes, validates and stores the pair k, v. The caller must hold the lock and have checked mutability.
+func (b *BiMap[K, V]) insertLocked(ctx context.Context, k K, v V) error {
+	return b.insertResolved(ctx, b.resolveKey(k), b.resolveValue(v))
+}
+
+// insertResolved is insertLocked for a key and value that have already been through resolveKey and resolveValue.
+func (b *BiMap[K, V]) insertResolved(ctx context.Context, k K, v V) error {
+	if err := b.validatePair(k, v); err != nil {
+		return err
+	}
+	old, replaced := b.forward[k]
+	if !replaced && b.maxEntries > 0 && len(b.forward) >= b.maxEntries {
+		return fmt.Errorf("%w: limit of %d entries reached", ErrQuotaExceeded, b.maxEntries)
+	}
+	if b.internKey != nil {
+		k = b.internKey(k)
+	}
+	if b.internValue != nil {
+		v = b.internValue(v)
+	}
+	if replaced && b.inverse[old] == k {
+		delete(b.inverse, old)
 	}
 	b.forward[k] = v
 	b.inverse[v] = k
+	if b.fold != nil {
+		b.folded[b.fold(k)] = k
+	}
+	if b.tombstones != nil {
+		delete(b.tombstones, k)
+	}
+	b.touch()
+	if b.audit != nil {
+		op := AuditInsert
+		if replaced {
+			op = AuditUpdate
+		}
+		b.audit.record(ctx, AuditEntry[K, V]{Op: op, Key: k, OldValue: old, NewValue: v})
+	}
+	if b.metrics != nil {
+		b.metrics.Inserted()
+	}
+	if replaced {
+		b.fireReplace(k, old, v)
+	} else {
+		b.fireInsert(k, v)
+	}
+	return nil
+}
+
+// validatePair runs the configured validators on a resolved key and value.
+func (b *BiMap[K, V]) validatePair(k K, v V) error {
+	if b.validateKey != nil {
+		if err := b.validateKey(k); err != nil {
+			return fmt.Errorf("%w %v: %w", ErrInvalidKey, k, err)
+		}
+	}
+	if b.validateValue != nil {
+		if err := b.validateValue(v); err != nil {
+			return fmt.Errorf("%w %v: %w", ErrInvalidValue, v, err)
+		}
+	}
+	return nil
+}
+
+// resolveKey returns the stored form of k after normalization and collation folding. The caller must hold the lock.
+func (b *BiMap[K, V]) resolveKey(k K) K {
+	if b.normKey != nil {
+		k = b.normKey(k)
+	}
+	if b.fold == nil {
+		return k
+	}
+	if stored, ok := b.folded[b.fold(k)]; ok {
+		return stored
+	}
+	return k
+}
+
+// resolveValue returns the stored form of v after normalization.
+func (b *BiMap[K, V]) resolveValue(v V) V {
+	if b.normValue != nil {
+		return b.normValue(v)
+	}
+	return v
 }
 
 // ExistsByKey checks whether or not a key exists in the BiMap.
 func (b *BiMap[K, V]) ExistsByKey(k K) bool {
-	b.s.RLock()
-	defer b.s.RUnlock()
-	_, ok := b.forward[k]
-	return ok
+	b.rlock()
+	defer b.runlock()
+	return b.ExistsByKeyUnlocked(k)
 }
 
 // Exists checks whether or not a key exists in the BiMap.
@@ -54,10 +343,9 @@ func (b *BiMap[K, V]) Exists(k K) bool { return b.ExistsByKey(k) }
 
 // ExistsByValue checks whether or not a value exists in the BiMap.
 func (b *BiMap[K, V]) ExistsByValue(k V) bool {
-	b.s.RLock()
-	defer b.s.RUnlock()
-	_, ok := b.inverse[k]
-	return ok
+	b.rlock()
+	defer b.runlock()
+	return b.ExistsByValueUnlocked(k)
 }
 
 // ExistsInverse checks whether or not a value exists in the BiMap.
@@ -67,10 +355,9 @@ func (b *BiMap[K, V]) ExistsInverse(k V) bool { return b.ExistsByValue(k) }
 
 // GetByKey returns the value for a given key in the BiMap and whether or not the element was present.
 func (b *BiMap[K, V]) GetByKey(k K) (V, bool) {
-	b.s.RLock()
-	defer b.s.RUnlock()
-	v, ok := b.forward[k]
-	return v, ok
+	b.rlock()
+	defer b.runlock()
+	return b.GetByKeyUnlocked(k)
 }
 
 // Get returns the value for a given key in the BiMap and whether or not the element was present.
@@ -80,10 +367,9 @@ func (b *BiMap[K, V]) Get(k K) (V, bool) { return b.GetByKey(k) }
 
 // GetByValue returns the key for a given value in the BiMap and whether or not the element was present.
 func (b *BiMap[K, V]) GetByValue(v V) (K, bool) {
-	b.s.RLock()
-	defer b.s.RUnlock()
-	k, ok := b.inverse[v]
-	return k, ok
+	b.rlock()
+	defer b.runlock()
+	return b.GetByValueUnlocked(v)
 }
 
 // GetInverse returns the key for a given value in the BiMap and whether or not the element was present.
@@ -108,18 +394,21 @@ func (b *BiMap[K, V]) GetByValueWithFallback(v V, fallback K) K {
 }
 
 // DeleteByKey removes a key-value pair from the BiMap for a given key. Returns if the key doesn't exist.
-func (b *BiMap[K, V]) DeleteByKey(k K) {
-	b.s.Lock()
-	defer b.s.Unlock()
+func (b *BiMap[K, V]) DeleteByKey(k K) { b.DeleteByKeyCtx(context.Background(), k) }
+
+// DeleteByKeyCtx is like DeleteByKey, attributing the change to the actor and request ID carried by ctx in the audit log.
+func (b *BiMap[K, V]) DeleteByKeyCtx(ctx context.Context, k K) {
+	b.lock()
+	defer b.unlock()
 	if b.immutable {
 		panic("Cannot modify immutable map")
 	}
+	k = b.resolveKey(k)
 	val, ok := b.forward[k]
 	if !ok {
 		return
 	}
-	delete(b.forward, k)
-	delete(b.inverse, val)
+	b.deletePair(ctx, k, val)
 }
 
 // Delete removes a key-value pair from the BiMap for a given key. Returns if the key doesn't exist.
@@ -128,18 +417,143 @@ func (b *BiMap[K, V]) DeleteByKey(k K) {
 func (b *BiMap[K, V]) Delete(k K) { b.DeleteByKey(k) }
 
 // DeleteByValue removes a key-value pair from the BiMap for a given value. Returns if the value doesn't exist.
-func (b *BiMap[K, V]) DeleteByValue(v V) {
-	b.s.Lock()
-	defer b.s.Unlock()
+func (b *BiMap[K, V]) DeleteByValue(v V) { b.DeleteByValueCtx(context.Background(), v) }
+
+// DeleteByValueCtx is like DeleteByValue, attributing the change to the actor and request ID carried by ctx in the audit log.
+func (b *BiMap[K, V]) DeleteByValueCtx(ctx context.Context, v V) {
+	b.lock()
+	defer b.unlock()
 	if b.immutable {
 		panic("Cannot modify immutable map")
 	}
+	v = b.resolveValue(v)
 	key, ok := b.inverse[v]
 	if !ok {
 		return
 	}
+	b.deletePair(ctx, key, v)
+}
+
+// TryDeleteByKey is like DeleteByKey but returns ErrImmutable instead of panicking if the BiMap is immutable.
+// It returns nil if the key doesn't exist.
+func (b *BiMap[K, V]) TryDeleteByKey(k K) error {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return ErrImmutable
+	}
+	k = b.resolveKey(k)
+	if v, ok := b.forward[k]; ok {
+		b.deletePair(context.Background(), k, v)
+	}
+	return nil
+}
+
+// TryDeleteByValue is like DeleteByValue but returns ErrImmutable instead of panicking if the BiMap is immutable.
+// It returns nil if the value doesn't exist.
+func (b *BiMap[K, V]) TryDeleteByValue(v V) error {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return ErrImmutable
+	}
+	v = b.resolveValue(v)
+	if k, ok := b.inverse[v]; ok {
+		b.deletePair(context.Background(), k, v)
+	}
+	return nil
+}
+
+// SwapValues atomically exchanges the values of k1 and k2, so concurrent readers never observe a broken pairing.
+// It returns ErrNotFound if either key is absent and ErrImmutable if the BiMap is immutable. Listeners see both
+// pairs deleted and then inserted with their new values.
+func (b *BiMap[K, V]) SwapValues(k1, k2 K) error {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return ErrImmutable
+	}
+	k1, k2 = b.resolveKey(k1), b.resolveKey(k2)
+	v1, ok := b.forward[k1]
+	if !ok {
+		return fmt.Errorf("%w: %v", ErrNotFound, k1)
+	}
+	v2, ok := b.forward[k2]
+	if !ok {
+		return fmt.Errorf("%w: %v", ErrNotFound, k2)
+	}
+	if k1 == k2 {
+		return nil
+	}
+	b.forward[k1], b.forward[k2] = v2, v1
+	b.inverse[v1], b.inverse[v2] = k2, k1
+	b.touch()
+	if b.audit != nil {
+		ctx := context.Background()
+		b.audit.record(ctx, AuditEntry[K, V]{Op: AuditUpdate, Key: k1, OldValue: v1, NewValue: v2})
+		b.audit.record(ctx, AuditEntry[K, V]{Op: AuditUpdate, Key: k2, OldValue: v2, NewValue: v1})
+	}
+	if b.metrics != nil {
+		b.metrics.Inserted()
+		b.metrics.Inserted()
+	}
+	// Two replace events would each be inconsistent on their own, so listeners see both pairs removed and re-added.
+	b.fireDelete(k1, v1)
+	b.fireDelete(k2, v2)
+	b.fireInsert(k1, v2)
+	b.fireInsert(k2, v1)
+	return nil
+}
+
+// PopByKey removes the pair for a given key and returns its value, and whether anything was removed, atomically.
+func (b *BiMap[K, V]) PopByKey(k K) (V, bool) {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		panic("Cannot modify immutable map")
+	}
+	k = b.resolveKey(k)
+	v, ok := b.forward[k]
+	if ok {
+		b.deletePair(context.Background(), k, v)
+	}
+	return v, ok
+}
+
+// PopByValue removes the pair for a given value and returns its key, and whether anything was removed, atomically.
+func (b *BiMap[K, V]) PopByValue(v V) (K, bool) {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		panic("Cannot modify immutable map")
+	}
+	v = b.resolveValue(v)
+	k, ok := b.inverse[v]
+	if ok {
+		b.deletePair(context.Background(), k, v)
+	}
+	return k, ok
+}
+
+// deletePair removes the pair k, v from both indexes, keeping a tombstone if soft deletes are enabled
+// and recording the deletion if auditing is enabled. The caller must hold the lock.
+func (b *BiMap[K, V]) deletePair(ctx context.Context, k K, v V) {
+	delete(b.forward, k)
 	delete(b.inverse, v)
-	delete(b.forward, key)
+	if b.fold != nil {
+		delete(b.folded, b.fold(k))
+	}
+	if b.tombstones != nil {
+		b.tombstones[k] = tombstone[V]{value: v, deletedAt: time.Now()}
+	}
+	b.touch()
+	if b.audit != nil {
+		b.audit.record(ctx, AuditEntry[K, V]{Op: AuditDelete, Key: k, OldValue: v})
+	}
+	if b.metrics != nil {
+		b.metrics.Deleted()
+	}
+	b.fireDelete(k, v)
 }
 
 // DeleteInverse removes a key-value pair from the BiMap for a given value. Returns if the value doesn't exist.
@@ -147,25 +561,68 @@ func (b *BiMap[K, V]) DeleteByValue(v V) {
 // Deprecated: Use DeleteByValue instead.
 func (b *BiMap[K, V]) DeleteInverse(v V) { b.DeleteByValue(v) }
 
+// Clear removes every pair from the BiMap, keeping its allocated maps so shared references stay valid.
+// With soft deletes, auditing or listeners enabled, each pair is removed as DeleteByKey would.
+func (b *BiMap[K, V]) Clear() {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		panic("Cannot modify immutable map")
+	}
+	b.clearLocked()
+	clear(b.forward)
+	clear(b.inverse)
+	clear(b.folded)
+	b.touch()
+}
+
+// ClearAndResize is like Clear but replaces the BiMap's maps with new ones sized for hint entries,
+// releasing the memory held by a previously large BiMap.
+func (b *BiMap[K, V]) ClearAndResize(hint int) {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		panic("Cannot modify immutable map")
+	}
+	b.clearLocked()
+	b.forward = make(map[K]V, hint)
+	b.inverse = make(map[V]K, hint)
+	if b.folded != nil {
+		b.folded = make(map[string]K, hint)
+	}
+	b.touch()
+}
+
+// clearLocked removes each pair individually when soft deletes, auditing, listeners or metrics need to observe it.
+// The caller must hold the lock.
+func (b *BiMap[K, V]) clearLocked() {
+	if b.tombstones == nil && b.audit == nil && b.hooks == nil && b.metrics == nil {
+		return
+	}
+	for k, v := range b.forward {
+		b.deletePair(context.Background(), k, v)
+	}
+}
+
 // Size returns the number of elements in the bimap
 func (b *BiMap[K, V]) Size() int {
-	b.s.RLock()
-	defer b.s.RUnlock()
-	return len(b.forward)
+	b.rlock()
+	defer b.runlock()
+	return b.SizeUnlocked()
 }
 
 // MakeImmutable freezes the BiMap preventing any further write actions from taking place
 func (b *BiMap[K, V]) MakeImmutable() {
-	b.s.Lock()
-	defer b.s.Unlock()
+	b.lock()
+	defer b.unlock()
 	b.immutable = true
 }
 
 // Freeze returns a new ImmutableBiMap with a snapshot of the current state.
 // The original BiMap is unaffected and remains mutable.
 func (b *BiMap[K, V]) Freeze() *ImmutableBiMap[K, V] {
-	b.s.RLock()
-	defer b.s.RUnlock()
+	b.rlock()
+	defer b.runlock()
 	forward := make(map[K]V, len(b.forward))
 	inverse := make(map[V]K, len(b.inverse))
 	for k, v := range b.forward {
@@ -177,22 +634,310 @@ func (b *BiMap[K, V]) Freeze() *ImmutableBiMap[K, V] {
 	return &ImmutableBiMap[K, V]{forward: forward, inverse: inverse}
 }
 
+// Clone returns an independent, mutable deep copy of the BiMap, taken under the read lock. The copy keeps the
+// BiMap's options and tombstones, but starts with an empty audit log and fresh contention and lookup counters. Listeners and
+// the WithMetrics Collector are not carried over.
+func (b *BiMap[K, V]) Clone() *BiMap[K, V] {
+	b.rlock()
+	defer b.runlock()
+	c := b.derive(maps.Clone(b.forward), maps.Clone(b.inverse))
+	c.tombstones = maps.Clone(b.tombstones)
+	if b.lastMutation != nil {
+		at := *b.lastMutation
+		c.lastMutation = &at
+	}
+	return c
+}
+
+// derive returns a mutable BiMap holding forward and inverse with the same options as b, an empty audit log and fresh
+// contention and lookup counters. The caller must hold the lock.
+func (b *BiMap[K, V]) derive(forward map[K]V, inverse map[V]K) *BiMap[K, V] {
+	c := &BiMap[K, V]{
+		forward:       forward,
+		inverse:       inverse,
+		fold:          b.fold,
+		normKey:       b.normKey,
+		normValue:     b.normValue,
+		internKey:     b.internKey,
+		internValue:   b.internValue,
+		maxEntries:    b.maxEntries,
+		validateKey:   b.validateKey,
+		validateValue: b.validateValue,
+		noLocking:     b.noLocking,
+	}
+	if b.contention != nil {
+		c.contention = &contention{}
+	}
+	if b.lookups != nil {
+		c.lookups = &lookups{}
+	}
+	if b.audit != nil {
+		c.audit = &auditLog[K, V]{entries: make(map[K][]AuditEntry[K, V])}
+	}
+	if b.tombstones != nil {
+		c.tombstones = make(map[K]tombstone[V])
+	}
+	if b.lastMutation != nil {
+		c.lastMutation = new(time.Time)
+	}
+	if b.fold != nil {
+		c.folded = make(map[string]K, len(forward))
+		for k := range forward {
+			c.folded[b.fold(k)] = k
+		}
+	}
+	return c
+}
+
+// Equal reports whether the BiMap and other hold the same key-value pairs, ignoring options and mutability.
+// The two BiMaps are never locked at the same time, so concurrent a.Equal(b) and b.Equal(a) cannot deadlock.
+func (b *BiMap[K, V]) Equal(other *BiMap[K, V]) bool {
+	if b == other {
+		return true
+	}
+	forward := other.SnapshotForward()
+	b.rlock()
+	defer b.runlock()
+	return maps.Equal(b.forward, forward)
+}
+
+// EqualImmutable reports whether the BiMap holds the same key-value pairs as the ImmutableBiMap other.
+func (b *BiMap[K, V]) EqualImmutable(other *ImmutableBiMap[K, V]) bool {
+	b.rlock()
+	defer b.runlock()
+	return maps.Equal(b.forward, other.forward)
+}
+
+// Inverse returns a copy of the BiMap with the roles of keys and values swapped, so GetByKey on the result
+// looks up by this BiMap's values. The copy is immutable if this BiMap is, and does not carry over options.
+func (b *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	b.rlock()
+	defer b.runlock()
+	return &BiMap[V, K]{forward: maps.Clone(b.inverse), inverse: maps.Clone(b.forward), immutable: b.immutable}
+}
+
+// All returns an iterator over the BiMap's key-value pairs, in no particular order.
+// The read lock is held for the duration of the loop, so the loop body must not modify the BiMap.
+func (b *BiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		b.rlock()
+		defer b.runlock()
+		for k, v := range b.forward {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Range calls fn for each key-value pair in the BiMap, in no particular order, stopping early if fn returns false.
+// The read lock is held for the duration of the call, so fn must not modify the BiMap.
+func (b *BiMap[K, V]) Range(fn func(k K, v V) bool) {
+	b.rlock()
+	defer b.runlock()
+	for k, v := range b.forward {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Keys returns an iterator over the BiMap's keys, in no particular order.
+// The read lock is held for the duration of the loop, so the loop body must not modify the BiMap.
+func (b *BiMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		b.rlock()
+		defer b.runlock()
+		for k := range b.forward {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the BiMap's values, in no particular order.
+// The read lock is held for the duration of the loop, so the loop body must not modify the BiMap.
+func (b *BiMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		b.rlock()
+		defer b.runlock()
+		for v := range b.inverse {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SortedKeys returns a snapshot of the BiMap's keys ordered by less, for producing stable output.
+func (b *BiMap[K, V]) SortedKeys(less func(a, b K) bool) []K {
+	b.rlock()
+	keys := slices.Collect(maps.Keys(b.forward))
+	b.runlock()
+	slices.SortFunc(keys, func(x, y K) int {
+		switch {
+		case less(x, y):
+			return -1
+		case less(y, x):
+			return 1
+		}
+		return 0
+	})
+	return keys
+}
+
+// SortedKeysOrdered returns a snapshot of b's keys in ascending order. It is a function rather than a method
+// because it requires an ordered key type.
+func SortedKeysOrdered[K cmp.Ordered, V comparable](b *BiMap[K, V]) []K {
+	b.rlock()
+	keys := slices.Collect(maps.Keys(b.forward))
+	b.runlock()
+	slices.Sort(keys)
+	return keys
+}
+
 // GetInverseMap returns a regular go map mapping from the BiMap's values to its keys
+//
+// Deprecated: The returned map is the live internal index, read without locking. Use SnapshotInverse for a copy,
+// or UnsafeInverseMap under Lock or RLock.
 func (b *BiMap[K, V]) GetInverseMap() map[V]K {
 	return b.inverse
 }
 
 // GetForwardMap returns a regular go map mapping from the BiMap's keys to its values
+//
+// Deprecated: The returned map is the live internal index, read without locking. Use SnapshotForward for a copy,
+// or UnsafeForwardMap under Lock or RLock.
 func (b *BiMap[K, V]) GetForwardMap() map[K]V {
 	return b.forward
 }
 
+// SnapshotForward returns a copy of the BiMap's key to value mapping, taken under the read lock.
+func (b *BiMap[K, V]) SnapshotForward() map[K]V {
+	b.rlock()
+	defer b.runlock()
+	return maps.Clone(b.forward)
+}
+
+// SnapshotInverse returns a copy of the BiMap's value to key mapping, taken under the read lock.
+func (b *BiMap[K, V]) SnapshotInverse() map[V]K {
+	b.rlock()
+	defer b.runlock()
+	return maps.Clone(b.inverse)
+}
+
+// UnsafeForwardMap returns the BiMap's internal key to value mapping without copying or locking.
+// The caller must hold Lock or RLock while using it and must not modify it.
+func (b *BiMap[K, V]) UnsafeForwardMap() map[K]V {
+	return b.forward
+}
+
+// UnsafeInverseMap returns the BiMap's internal value to key mapping without copying or locking.
+// The caller must hold Lock or RLock while using it and must not modify it.
+func (b *BiMap[K, V]) UnsafeInverseMap() map[V]K {
+	return b.inverse
+}
+
+// GetByKeyUnlocked is GetByKey without locking, for use between Lock or RLock and the matching unlock.
+// Calling it without holding the lock while another goroutine modifies the BiMap is a data race.
+func (b *BiMap[K, V]) GetByKeyUnlocked(k K) (V, bool) {
+	v, ok := b.forward[b.resolveKey(k)]
+	b.recordLookup(false, ok)
+	return v, ok
+}
+
+// GetByValueUnlocked is GetByValue without locking. The caller must hold Lock or RLock.
+func (b *BiMap[K, V]) GetByValueUnlocked(v V) (K, bool) {
+	k, ok := b.inverse[b.resolveValue(v)]
+	b.recordLookup(true, ok)
+	return k, ok
+}
+
+// ExistsByKeyUnlocked is ExistsByKey without locking. The caller must hold Lock or RLock.
+func (b *BiMap[K, V]) ExistsByKeyUnlocked(k K) bool {
+	_, ok := b.forward[b.resolveKey(k)]
+	return ok
+}
+
+// ExistsByValueUnlocked is ExistsByValue without locking. The caller must hold Lock or RLock.
+func (b *BiMap[K, V]) ExistsByValueUnlocked(v V) bool {
+	_, ok := b.inverse[b.resolveValue(v)]
+	return ok
+}
+
+// SizeUnlocked is Size without locking. The caller must hold Lock or RLock.
+func (b *BiMap[K, V]) SizeUnlocked() int {
+	return len(b.forward)
+}
+
 // Lock manually locks the BiMap's mutex
 func (b *BiMap[K, V]) Lock() {
-	b.s.Lock()
+	b.lock()
 }
 
 // Unlock manually unlocks the BiMap's mutex
 func (b *BiMap[K, V]) Unlock() {
-	b.s.Unlock()
+	b.unlock()
+}
+
+// RLock manually locks the BiMap's mutex for reading, allowing other readers to proceed concurrently.
+// The BiMap's own methods must not be called while holding the lock, as a waiting writer would deadlock them.
+func (b *BiMap[K, V]) RLock() {
+	b.rlock()
+}
+
+// RLockCtx locks the BiMap's mutex for reading, giving up and returning the context's error if ctx is done first.
+// With a locker from NewBiMapWithLocker that has no TryRLock method, it blocks like RLock.
+func (b *BiMap[K, V]) RLockCtx(ctx context.Context) error {
+	if _, ok := b.locker.(interface{ TryRLock() bool }); b.locker != nil && !ok {
+		b.rlock()
+		return nil
+	}
+	return acquireCtx(ctx, b.tryRLock)
+}
+
+// TryLock tries to lock the BiMap's mutex for writing and reports whether it succeeded. With a locker from
+// NewBiMapWithLocker, it always fails unless the locker has a TryLock method.
+func (b *BiMap[K, V]) TryLock() bool {
+	return b.tryLock()
+}
+
+// TryRLock tries to lock the BiMap's mutex for reading and reports whether it succeeded. Release it with RUnlock.
+// With a locker from NewBiMapWithLocker, it always fails unless the locker has a TryRLock method.
+func (b *BiMap[K, V]) TryRLock() bool {
+	return b.tryRLock()
+}
+
+// RUnlock manually releases a read lock on the BiMap's mutex
+func (b *BiMap[K, V]) RUnlock() {
+	b.runlock()
+}
+
+// LockCtx locks the BiMap's mutex for writing, giving up and returning the context's error if ctx is done first.
+// With a locker from NewBiMapWithLocker that has no TryLock method, it blocks like Lock.
+func (b *BiMap[K, V]) LockCtx(ctx context.Context) error {
+	if _, ok := b.locker.(interface{ TryLock() bool }); b.locker != nil && !ok {
+		b.lock()
+		return nil
+	}
+	return acquireCtx(ctx, b.tryLock)
+}
+
+// acquireCtx retries tryLock with exponential backoff until it succeeds or ctx is done.
+func acquireCtx(ctx context.Context, tryLock func() bool) error {
+	backoff := time.Microsecond
+	for !tryLock() {
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		if backoff < time.Millisecond {
+			backoff *= 2
+		}
+	}
+	return nil
 }
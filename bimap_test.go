@@ -1,8 +1,15 @@
 package bimap
 
 import (
+	"context"
+	"errors"
+	"maps"
 	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -31,6 +38,21 @@ func TestNewBiMapFrom(t *testing.T) {
 	assert.Equal(t, expected, actual, "They should be equal")
 }
 
+func TestNewBiMapFromSlices(t *testing.T) {
+	actual, err := NewBiMapFromSlices([]string{"a", "b"}, []int{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, actual.SnapshotForward())
+
+	_, err = NewBiMapFromSlices([]string{"a"}, []int{1, 2})
+	assert.ErrorIs(t, err, ErrLengthMismatch)
+	_, err = NewBiMapFromSlices([]string{"a", "a"}, []int{1, 2})
+	assert.ErrorIs(t, err, ErrDuplicateKey)
+	_, err = NewBiMapFromSlices([]string{"a", "b"}, []int{1, 1})
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+	_, err = NewBiMapFromSlices([]string{"a", "b"}, []int{1, 2}, WithMaxEntries(1))
+	assert.ErrorIs(t, err, ErrQuotaExceeded, "Options should apply while building")
+}
+
 func TestBiMap_Insert(t *testing.T) {
 	actual := NewBiMap[string, string]()
 	actual.Insert(key, value)
@@ -296,3 +318,487 @@ func TestBiMap_Freeze(t *testing.T) {
 	// Frozen map does not affect the original
 	assert.Equal(t, 3, mutable.Size(), "Original should still have all insertions")
 }
+
+func TestBiMap_TryLock(t *testing.T) {
+	actual := NewBiMap[string, int]()
+
+	assert.True(t, actual.TryLock(), "Unlocked map should be lockable")
+	assert.False(t, actual.TryLock(), "Locked map should not be lockable")
+	assert.False(t, actual.TryRLock(), "Locked map should not be read-lockable")
+	actual.Unlock()
+
+	assert.True(t, actual.TryRLock(), "Unlocked map should be read-lockable")
+	assert.True(t, actual.TryRLock(), "Read locks should be shared")
+	assert.False(t, actual.TryLock(), "Read-locked map should not be lockable")
+	actual.RUnlock()
+	actual.RUnlock()
+}
+
+func TestBiMap_LockCtx(t *testing.T) {
+	actual := NewBiMap[string, int]()
+
+	assert.NoError(t, actual.LockCtx(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, actual.LockCtx(ctx), context.DeadlineExceeded, "It should give up when the context expires")
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		actual.Unlock()
+	}()
+	assert.NoError(t, actual.LockCtx(context.Background()), "It should acquire the lock once released")
+	actual.Unlock()
+}
+
+func TestBiMap_RLock(t *testing.T) {
+	actual := NewBiMap[string, int]()
+
+	actual.RLock()
+	assert.True(t, actual.TryRLock(), "Read locks should be shared")
+	assert.False(t, actual.TryLock(), "Writers should be excluded")
+	actual.RUnlock()
+	actual.RUnlock()
+
+	actual.Lock()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, actual.RLockCtx(ctx), context.DeadlineExceeded, "It should give up when the context expires")
+	actual.Unlock()
+
+	assert.NoError(t, actual.RLockCtx(context.Background()))
+	actual.RUnlock()
+}
+
+func TestBiMap_All(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3}, maps.Collect(actual.All()))
+
+	count := 0
+	for range actual.All() {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count, "Iteration should stop on break")
+	assert.NotPanics(t, func() { actual.Insert("d", 4) }, "Lock should be released after breaking out of the loop")
+}
+
+func TestBiMap_Range(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	seen := make(map[string]int)
+	actual.Range(func(k string, v int) bool {
+		seen[k] = v
+		return true
+	})
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3}, seen)
+
+	count := 0
+	actual.Range(func(string, int) bool {
+		count++
+		return false
+	})
+	assert.Equal(t, 1, count, "Iteration should stop when fn returns false")
+	assert.NotPanics(t, func() { actual.Insert("d", 4) }, "Lock should be released after Range returns")
+}
+
+func TestBiMap_KeysAndValues(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	assert.Equal(t, []string{"a", "b"}, slices.Sorted(actual.Keys()))
+	assert.Equal(t, []int{1, 2}, slices.Sorted(actual.Values()))
+}
+
+func TestBiMap_SortedKeys(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"bb": 1, "a": 2, "ccc": 3})
+
+	byLength := actual.SortedKeys(func(a, b string) bool { return len(a) > len(b) })
+	assert.Equal(t, []string{"ccc", "bb", "a"}, byLength)
+	assert.Equal(t, []string{"a", "bb", "ccc"}, SortedKeysOrdered(actual))
+	assert.Empty(t, SortedKeysOrdered(NewBiMap[int, int]()))
+}
+
+func TestBiMap_Put(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	assert.NoError(t, actual.Put("a", 10), "Replacing a key's value should succeed")
+	assert.NoError(t, actual.Put("c", 3))
+	assert.ErrorIs(t, actual.Put("d", 2), ErrDuplicateValue)
+	assert.ErrorIs(t, actual.Put("a", 2), ErrDuplicateValue)
+	assert.Equal(t, map[string]int{"a": 10, "b": 2, "c": 3}, actual.SnapshotForward())
+	assert.NoError(t, actual.Validate())
+
+	actual.MakeImmutable()
+	assert.ErrorIs(t, actual.Put("e", 5), ErrImmutable)
+}
+
+func TestBiMap_ForcePut(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	actual.ForcePut("a", 2)
+	assert.Equal(t, map[string]int{"a": 2}, actual.SnapshotForward(), "Both previous pairings should be removed")
+	assert.NoError(t, actual.Validate())
+
+	actual.MakeImmutable()
+	assert.PanicsWithValue(t, "Cannot modify immutable map", func() { actual.ForcePut("c", 3) })
+}
+
+func TestBiMap_InsertReturning(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	d, err := actual.InsertReturning("c", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, Displaced[string, int]{}, d, "A fresh pair should displace nothing")
+
+	d, err = actual.InsertReturning("a", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, Displaced[string, int]{Value: 1, Replaced: true}, d)
+
+	d, err = actual.InsertReturning("a", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, Displaced[string, int]{Value: 10, Replaced: true, Key: "b", Unlinked: true}, d)
+	assert.Equal(t, map[string]int{"a": 2, "c": 3}, actual.SnapshotForward())
+	assert.NoError(t, actual.Validate(), "Unlinked keys should not be left dangling")
+
+	d, err = actual.InsertReturning("a", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, Displaced[string, int]{Value: 2, Replaced: true}, d, "Reinserting a pair should not unlink its own key")
+
+	actual.MakeImmutable()
+	_, err = actual.InsertReturning("z", 26)
+	assert.ErrorIs(t, err, ErrImmutable)
+}
+
+func TestBiMap_InsertReturning_Invalid(t *testing.T) {
+	actual := NewBiMap[string, int](WithKeyValidator(func(k string) error {
+		if k == "" {
+			return errors.New("empty")
+		}
+		return nil
+	}))
+	actual.Insert("a", 1)
+
+	_, err := actual.InsertReturning("", 1)
+	assert.ErrorIs(t, err, ErrInvalidKey)
+	assert.Equal(t, map[string]int{"a": 1}, actual.SnapshotForward(), "Rejected inserts should unlink nothing")
+}
+
+func TestBiMap_InsertStrict(t *testing.T) {
+	actual := NewBiMap[string, int]()
+
+	assert.NoError(t, actual.InsertStrict("a", 1))
+	assert.ErrorIs(t, actual.InsertStrict("a", 2), ErrDuplicateKey)
+	assert.ErrorIs(t, actual.InsertStrict("b", 1), ErrDuplicateValue)
+
+	expected := NewBiMapFromMap(map[string]int{"a": 1})
+	assert.Equal(t, expected.GetForwardMap(), actual.GetForwardMap(), "Rejected inserts should leave the BiMap unchanged")
+	assert.Equal(t, expected.GetInverseMap(), actual.GetInverseMap(), "Rejected inserts should leave the BiMap unchanged")
+}
+
+func TestBiMap_InsertStrictImmutable(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	actual.MakeImmutable()
+
+	assert.ErrorIs(t, actual.InsertStrict("a", 1), ErrImmutable)
+	assert.Equal(t, 0, actual.Size())
+}
+
+func TestBiMap_Snapshot(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1})
+
+	forward := actual.SnapshotForward()
+	inverse := actual.SnapshotInverse()
+	assert.Equal(t, map[string]int{"a": 1}, forward)
+	assert.Equal(t, map[int]string{1: "a"}, inverse)
+
+	forward["b"] = 2
+	inverse[2] = "b"
+	actual.Insert("c", 3)
+	assert.False(t, actual.ExistsByKey("b"), "Mutating a snapshot should not affect the BiMap")
+	assert.NotContains(t, forward, "c", "Snapshot should not see later inserts")
+}
+
+func TestBiMap_UnsafeMaps(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1})
+
+	actual.RLock()
+	forward := actual.UnsafeForwardMap()
+	inverse := actual.UnsafeInverseMap()
+	actual.RUnlock()
+
+	actual.Insert("b", 2)
+	assert.Equal(t, 2, forward["b"], "Unsafe map should be the live index")
+	assert.Equal(t, "b", inverse[2], "Unsafe map should be the live index")
+}
+
+func TestBiMap_LookupAllocs(t *testing.T) {
+	ints := NewBiMapFromMap(map[int]int{1: -1})
+	strs := NewBiMapFromMap(map[string]string{"a": "b"})
+
+	for name, lookup := range map[string]func(){
+		"GetByKey":      func() { ints.GetByKey(1) },
+		"GetByValue":    func() { ints.GetByValue(-1) },
+		"ExistsByKey":   func() { strs.ExistsByKey("a") },
+		"ExistsByValue": func() { strs.ExistsByValue("missing") },
+		"Size":          func() { strs.Size() },
+	} {
+		assert.Zero(t, testing.AllocsPerRun(100, lookup), "%s should not allocate", name)
+	}
+}
+
+func TestBiMap_UnlockedAccessors(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	actual.Insert("A", 1)
+
+	actual.RLock()
+	defer actual.RUnlock()
+	v, ok := actual.GetByKeyUnlocked("A")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	k, ok := actual.GetByValueUnlocked(1)
+	assert.True(t, ok)
+	assert.Equal(t, "A", k)
+	assert.True(t, actual.ExistsByKeyUnlocked("A"))
+	assert.True(t, actual.ExistsByValueUnlocked(1))
+	assert.False(t, actual.ExistsByValueUnlocked(2))
+	assert.Equal(t, 1, actual.SizeUnlocked())
+}
+
+func TestBiMap_GetOrInsert(t *testing.T) {
+	actual := NewBiMap[string, int]()
+
+	v, loaded := actual.GetOrInsert("a", 1)
+	assert.False(t, loaded)
+	assert.Equal(t, 1, v)
+
+	v, loaded = actual.GetOrInsert("a", 2)
+	assert.True(t, loaded)
+	assert.Equal(t, 1, v, "Existing value should be returned")
+	assert.False(t, actual.ExistsByValue(2), "Existing value should not be replaced")
+}
+
+func TestBiMap_GetOrInsertConcurrent(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	var wg sync.WaitGroup
+	var inserted atomic.Int32
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, loaded := actual.GetOrInsert(key, i); !loaded {
+				inserted.Add(1)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), inserted.Load(), "Exactly one goroutine should insert")
+	assert.Equal(t, 1, actual.Size())
+}
+
+func TestBiMap_Upsert(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	increment := func(old int, exists bool) int {
+		if !exists {
+			return 1
+		}
+		return old + 1
+	}
+
+	actual.Upsert(key, increment)
+	actual.Upsert(key, increment)
+	v, _ := actual.GetByKey(key)
+	assert.Equal(t, 2, v)
+	assert.False(t, actual.ExistsByValue(1), "Old value should be replaced")
+}
+
+func TestBiMap_GetOrInsertResolvesOnce(t *testing.T) {
+	calls := 0
+	actual := NewBiMap[string, int](WithKeyNormalizer(func(k string) string {
+		calls++
+		return k
+	}))
+
+	actual.GetOrInsert("a", 1)
+	assert.Equal(t, 1, calls, "GetOrInsert should normalize the key once")
+	calls = 0
+	actual.Upsert("b", func(int, bool) int { return 2 })
+	assert.Equal(t, 1, calls, "Upsert should normalize the key once")
+}
+
+func TestBiMap_UpsertConcurrent(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			actual.Upsert(key, func(old int, _ bool) int { return old + 1 })
+		}()
+	}
+	wg.Wait()
+
+	v, _ := actual.GetByKey(key)
+	assert.Equal(t, 50, v, "No update should be lost")
+}
+
+func TestBiMap_Clear(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+	shared := actual
+
+	actual.Clear()
+	assert.Equal(t, 0, shared.Size())
+	assert.Empty(t, actual.SnapshotInverse())
+
+	shared.Insert("c", 3)
+	assert.True(t, actual.ExistsByValue(3), "Cleared BiMap should remain usable")
+}
+
+func TestBiMap_ClearAndResize(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	actual.ClearAndResize(100)
+	assert.Equal(t, 0, actual.Size())
+	assert.Empty(t, actual.SnapshotInverse())
+	actual.Insert("c", 3)
+	assert.Equal(t, 1, actual.Size())
+}
+
+func TestBiMap_ClearImmutable(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1})
+	actual.MakeImmutable()
+
+	assert.Panics(t, func() { actual.Clear() })
+	assert.Panics(t, func() { actual.ClearAndResize(0) })
+	assert.Equal(t, 1, actual.Size())
+}
+
+func TestNewBiMapWithCapacity(t *testing.T) {
+	actual := NewBiMapWithCapacity[string, int](1000)
+	assert.Equal(t, 0, actual.Size())
+	actual.Insert("a", 1)
+	v, _ := actual.GetByKey("a")
+	assert.Equal(t, 1, v)
+}
+
+func TestBiMap_Inverse(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	inverse := actual.Inverse()
+	k, ok := inverse.GetByKey(1)
+	assert.True(t, ok)
+	assert.Equal(t, "a", k)
+	v, ok := inverse.GetByValue("b")
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+
+	inverse.Insert(3, "c")
+	assert.False(t, actual.ExistsByKey("c"), "Inverse should be a copy")
+
+	actual.MakeImmutable()
+	assert.Panics(t, func() { actual.Inverse().Insert(4, "d") }, "Inverse of an immutable BiMap should be immutable")
+}
+
+func TestBiMap_Equal(t *testing.T) {
+	a := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+	b := NewBiMapFromMap(map[string]int{"b": 2, "a": 1})
+	b.MakeImmutable()
+
+	assert.True(t, a.Equal(b), "Mutability should not affect equality")
+	assert.True(t, a.Equal(a))
+	assert.False(t, a.Equal(NewBiMapFromMap(map[string]int{"a": 1})))
+	assert.False(t, a.Equal(NewBiMapFromMap(map[string]int{"a": 1, "b": 3})))
+
+	assert.True(t, a.EqualImmutable(a.Freeze()))
+	assert.False(t, a.EqualImmutable(NewImmutableBiMapFromMap(map[string]int{"a": 1})))
+}
+
+func TestBiMap_Clone(t *testing.T) {
+	src := NewBiMapFromMap(map[string]int{"a": 1, "b": 2}, WithMaxEntries(3))
+	src.MakeImmutable()
+
+	clone := src.Clone()
+	assert.True(t, clone.Equal(src))
+
+	clone.Insert("c", 3)
+	clone.DeleteByKey("a")
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, src.SnapshotForward(), "Source should be unaffected by the clone")
+	assert.Equal(t, map[int]string{2: "b", 3: "c"}, clone.SnapshotInverse())
+	clone.Insert("d", 4)
+	assert.ErrorIs(t, clone.TryInsert("e", 5), ErrQuotaExceeded, "Clone should keep the source's options")
+}
+
+func TestNewBiMapFromBiMap(t *testing.T) {
+	src := NewBiMapFromMap(map[string]int{"a": 1})
+
+	actual := NewBiMapFromBiMap(src)
+	actual.Insert("b", 2)
+	assert.Equal(t, 1, src.Size())
+	assert.Equal(t, 2, actual.Size())
+}
+
+func TestBiMap_PopByKey(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	v, ok := actual.PopByKey("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.False(t, actual.ExistsByValue(1))
+
+	v, ok = actual.PopByKey("a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, v)
+	assert.Equal(t, 1, actual.Size())
+}
+
+func TestBiMap_PopByValue(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	k, ok := actual.PopByValue(2)
+	assert.True(t, ok)
+	assert.Equal(t, "b", k)
+	assert.False(t, actual.ExistsByKey("b"))
+
+	_, ok = actual.PopByValue(2)
+	assert.False(t, ok)
+
+	actual.MakeImmutable()
+	assert.Panics(t, func() { actual.PopByValue(1) })
+}
+
+func TestBiMap_TryMethodsImmutable(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1})
+	assert.NoError(t, actual.TryDeleteByKey("missing"))
+	assert.NoError(t, actual.TryDeleteByValue(99))
+	actual.MakeImmutable()
+
+	assert.ErrorIs(t, actual.TryInsert("b", 2), ErrImmutable)
+	assert.ErrorIs(t, actual.TryDeleteByKey("a"), ErrImmutable)
+	assert.ErrorIs(t, actual.TryDeleteByValue(1), ErrImmutable)
+	assert.Equal(t, 1, actual.Size())
+}
+
+func TestBiMap_TryDelete(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	assert.NoError(t, actual.TryDeleteByKey("a"))
+	assert.NoError(t, actual.TryDeleteByValue(2))
+	assert.Equal(t, 0, actual.Size())
+	assert.Empty(t, actual.SnapshotInverse())
+}
+
+func TestBiMap_SwapValues(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	assert.NoError(t, actual.SwapValues("a", "b"))
+	assert.Equal(t, map[string]int{"a": 2, "b": 1, "c": 3}, actual.SnapshotForward())
+	assert.Equal(t, map[int]string{1: "b", 2: "a", 3: "c"}, actual.SnapshotInverse())
+
+	assert.NoError(t, actual.SwapValues("c", "c"))
+	assert.ErrorIs(t, actual.SwapValues("a", "missing"), ErrNotFound)
+	assert.ErrorIs(t, actual.SwapValues("missing", "a"), ErrNotFound)
+
+	actual.MakeImmutable()
+	assert.ErrorIs(t, actual.SwapValues("a", "b"), ErrImmutable)
+}
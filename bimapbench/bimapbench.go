@@ -0,0 +1,198 @@
+// Package bimapbench provides a conformance suite and comparative benchmarks for bidirectional map
+// implementations, so the package's variants, or third-party ones, can be checked and compared on equal terms.
+package bimapbench
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/adrianlungu/bimap"
+)
+
+// Map is the set of operations the suite exercises.
+type Map[K comparable, V comparable] interface {
+	Insert(k K, v V)
+	DeleteByKey(k K)
+	DeleteByValue(v V)
+	GetByKey(k K) (V, bool)
+	GetByValue(v V) (K, bool)
+	Size() int
+}
+
+// Implementation is a named constructor of empty maps.
+type Implementation struct {
+	Name string
+	New  func() Map[int, int]
+}
+
+// Implementations returns the variants provided by the bimap package.
+func Implementations() []Implementation {
+	return []Implementation{
+		{Name: "plain", New: func() Map[int, int] { return bimap.NewBiMap[int, int]() }},
+		{Name: "sorted", New: func() Map[int, int] { return bimap.NewSortedBiMap[int, int]() }},
+		{Name: "sharded", New: func() Map[int, int] { return bimap.NewShardedBiMap[int, int](0) }},
+		{Name: "ordered", New: func() Map[int, int] { return bimap.NewOrderedBiMap[int, int]() }},
+		{Name: "concurrent", New: func() Map[int, int] { return bimap.NewConcurrentBiMap[int, int]() }},
+		{Name: "cow", New: func() Map[int, int] { return bimap.NewCOWBiMap[int, int]() }},
+		{Name: "compact", New: func() Map[int, int] { return bimap.NewCompactBiMap[int, int]() }},
+	}
+}
+
+// RunConformance checks that maps built by newMap behave as bidirectional maps, including under concurrent use.
+// Run it with -race to detect data races.
+func RunConformance(t *testing.T, newMap func() Map[int, int]) {
+	t.Run("InsertAndGet", func(t *testing.T) {
+		m := newMap()
+		m.Insert(1, 10)
+		m.Insert(2, 20)
+		expectPair(t, m, 1, 10)
+		expectPair(t, m, 2, 20)
+		expectSize(t, m, 2)
+	})
+	t.Run("ReplaceValue", func(t *testing.T) {
+		m := newMap()
+		m.Insert(1, 10)
+		m.Insert(1, 11)
+		expectPair(t, m, 1, 11)
+		if _, ok := m.GetByValue(10); ok {
+			t.Error("replaced value 10 is still present")
+		}
+		expectSize(t, m, 1)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		m := newMap()
+		m.Insert(1, 10)
+		m.Insert(2, 20)
+		m.DeleteByKey(1)
+		m.DeleteByValue(20)
+		m.DeleteByKey(3)
+		m.DeleteByValue(30)
+		if _, ok := m.GetByKey(1); ok {
+			t.Error("deleted key 1 is still present")
+		}
+		if _, ok := m.GetByValue(20); ok {
+			t.Error("deleted value 20 is still present")
+		}
+		expectSize(t, m, 0)
+	})
+	t.Run("Concurrent", func(t *testing.T) {
+		m := newMap()
+		const writers, perWriter = 4, 200
+		var wg sync.WaitGroup
+		for w := 0; w < writers; w++ {
+			wg.Add(2)
+			go func(w int) {
+				defer wg.Done()
+				for i := 0; i < perWriter; i++ {
+					k := w*perWriter + i
+					m.Insert(k, -k)
+				}
+			}(w)
+			go func(w int) {
+				defer wg.Done()
+				for i := 0; i < perWriter; i++ {
+					k := w*perWriter + i
+					if v, ok := m.GetByKey(k); ok && v != -k {
+						t.Errorf("GetByKey(%d) = %d, want %d", k, v, -k)
+					}
+					m.Size()
+				}
+			}(w)
+		}
+		wg.Wait()
+		expectSize(t, m, writers*perWriter)
+		for k := 0; k < writers*perWriter; k++ {
+			expectPair(t, m, k, -k)
+		}
+	})
+}
+
+// RunBenchmarks benchmarks lookups and writes of each implementation at several sizes, and parallel lookups with
+// 1 and 8 goroutines per GOMAXPROCS. Allocations are reported for every benchmark. Maps are only built for the
+// benchmarks selected with -bench.
+func RunBenchmarks(b *testing.B, impls []Implementation) {
+	sort.SliceStable(impls, func(i, j int) bool { return impls[i].Name < impls[j].Name })
+	for _, size := range []int{16, 1024, 65536} {
+		for _, impl := range impls {
+			filled := func() Map[int, int] {
+				m := impl.New()
+				for i := 0; i < size; i++ {
+					m.Insert(i, -i)
+				}
+				return m
+			}
+			b.Run(fmt.Sprintf("GetByKey/%s/%d", impl.Name, size), func(b *testing.B) {
+				m := filled()
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					m.GetByKey(i % size)
+				}
+			})
+			b.Run(fmt.Sprintf("GetByValue/%s/%d", impl.Name, size), func(b *testing.B) {
+				m := filled()
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					m.GetByValue(-(i % size))
+				}
+			})
+			for _, p := range []int{1, 8} {
+				b.Run(fmt.Sprintf("ParallelGetByKey/%s/%d/p%d", impl.Name, size, p), func(b *testing.B) {
+					m := filled()
+					b.ReportAllocs()
+					b.SetParallelism(p)
+					b.ResetTimer()
+					b.RunParallel(func(pb *testing.PB) {
+						i := 0
+						for pb.Next() {
+							m.GetByKey(i % size)
+							i++
+						}
+					})
+				})
+				b.Run(fmt.Sprintf("ParallelGetByValue/%s/%d/p%d", impl.Name, size, p), func(b *testing.B) {
+					m := filled()
+					b.ReportAllocs()
+					b.SetParallelism(p)
+					b.ResetTimer()
+					b.RunParallel(func(pb *testing.PB) {
+						i := 0
+						for pb.Next() {
+							m.GetByValue(-(i % size))
+							i++
+						}
+					})
+				})
+			}
+			b.Run(fmt.Sprintf("Insert/%s/%d", impl.Name, size), func(b *testing.B) {
+				m := filled()
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					k := i % size
+					m.Insert(k, -k)
+				}
+			})
+		}
+	}
+}
+
+func expectPair(t *testing.T, m Map[int, int], k, v int) {
+	t.Helper()
+	if got, ok := m.GetByKey(k); !ok || got != v {
+		t.Errorf("GetByKey(%d) = %d, %t; want %d, true", k, got, ok, v)
+	}
+	if got, ok := m.GetByValue(v); !ok || got != k {
+		t.Errorf("GetByValue(%d) = %d, %t; want %d, true", v, got, ok, k)
+	}
+}
+
+func expectSize(t *testing.T, m Map[int, int], want int) {
+	t.Helper()
+	if got := m.Size(); got != want {
+		t.Errorf("Size() = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,33 @@
+package bimapbench
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adrianlungu/bimap"
+)
+
+func TestConformance(t *testing.T) {
+	impls := append(Implementations(), Implementation{
+		Name: "versioned",
+		New:  func() Map[int, int] { return bimap.NewVersionedBiMap[int, int](1) },
+	}, Implementation{
+		Name: "expiring",
+		New:  func() Map[int, int] { return bimap.NewExpiringBiMap[int, int](time.Hour, 0) },
+	}, Implementation{
+		Name: "bounded",
+		New:  func() Map[int, int] { return bimap.NewBoundedBiMap[int, int](1 << 20) },
+	})
+	for _, impl := range impls {
+		t.Run(impl.Name, func(t *testing.T) {
+			RunConformance(t, impl.New)
+		})
+	}
+}
+
+func BenchmarkImplementations(b *testing.B) {
+	RunBenchmarks(b, append(Implementations(), Implementation{
+		Name: "unlocked",
+		New:  func() Map[int, int] { return bimap.NewUnsafeBiMap[int, int]() },
+	}))
+}
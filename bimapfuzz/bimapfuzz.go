@@ -0,0 +1,118 @@
+// Package bimapfuzz interprets arbitrary bytes as a sequence of BiMap operations and checks
+// the bijection invariants after each one, for use as a go test -fuzz target.
+package bimapfuzz
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/adrianlungu/bimap"
+)
+
+// opSize is the number of bytes consumed per operation: an opcode, a key and a value.
+const opSize = 3
+
+const (
+	opInsert = iota
+	opDeleteByKey
+	opDeleteByValue
+	opRenameKey
+	opMerge
+	opCount
+)
+
+// ApplyOps applies the operations encoded in data to a new BiMap and returns an error describing
+// the first invariant violation, or nil. Each operation is three bytes: an opcode, a key and a value.
+// Trailing bytes that do not form a whole operation are ignored.
+func ApplyOps(data []byte) error {
+	return ApplyOpsTo(bimap.NewBiMap[byte, byte](), data)
+}
+
+// ApplyOpsTo is like ApplyOps but drives the given BiMap, which must be mutable.
+func ApplyOpsTo(b *bimap.BiMap[byte, byte], data []byte) error {
+	model := make(map[byte]byte)
+	for k, v := range b.SnapshotForward() {
+		model[k] = v
+	}
+	for i := 0; i+opSize <= len(data); i += opSize {
+		op, k, v := data[i]%opCount, data[i+1], data[i+2]
+		switch op {
+		case opInsert:
+			// Insert does not unlink another key already mapped to v, so only
+			// bijection-preserving inserts are generated.
+			if owner, ok := b.GetByValue(v); ok && owner != k {
+				continue
+			}
+			b.Insert(k, v)
+			model[k] = v
+		case opDeleteByKey:
+			b.DeleteByKey(k)
+			delete(model, k)
+		case opDeleteByValue:
+			b.DeleteByValue(v)
+			for mk, mv := range model {
+				if mv == v {
+					delete(model, mk)
+				}
+			}
+		case opRenameKey:
+			// The value byte is the new key.
+			err := b.RenameKey(k, v)
+			mv, found := model[k]
+			_, taken := model[v]
+			switch {
+			case !found:
+				if !errors.Is(err, bimap.ErrNotFound) {
+					return fmt.Errorf("after op %d: renaming missing key %d returned %v", i/opSize, k, err)
+				}
+			case taken && v != k:
+				if !errors.Is(err, bimap.ErrDuplicateKey) {
+					return fmt.Errorf("after op %d: renaming %d onto existing key %d returned %v", i/opSize, k, v, err)
+				}
+			case err != nil:
+				return fmt.Errorf("after op %d: renaming %d to %d: %w", i/opSize, k, v, err)
+			default:
+				delete(model, k)
+				model[v] = mv
+			}
+		case opMerge:
+			// Merges the single pair k, v, keeping the incoming value on key conflicts.
+			err := b.Merge(bimap.NewBiMapFromMap(map[byte]byte{k: v}), nil)
+			owner, held := b.GetByValue(v)
+			switch {
+			case held && owner != k:
+				if !errors.Is(err, bimap.ErrDuplicateValue) {
+					return fmt.Errorf("after op %d: merging %d -> %d held by %d returned %v", i/opSize, k, v, owner, err)
+				}
+			case err != nil:
+				return fmt.Errorf("after op %d: merging %d -> %d: %w", i/opSize, k, v, err)
+			default:
+				model[k] = v
+			}
+		}
+		if err := Check(b, model); err != nil {
+			return fmt.Errorf("after op %d (%d %d %d): %w", i/opSize, op, k, v, err)
+		}
+	}
+	return nil
+}
+
+// Check verifies that b's forward and inverse indexes mirror each other exactly and hold the same pairs as model.
+func Check[K comparable, V comparable](b *bimap.BiMap[K, V], model map[K]V) error {
+	forward, inverse := b.SnapshotForward(), b.SnapshotInverse()
+	if len(forward) != len(inverse) {
+		return fmt.Errorf("forward has %d entries but inverse has %d", len(forward), len(inverse))
+	}
+	if b.Size() != len(model) {
+		return fmt.Errorf("size is %d, want %d", b.Size(), len(model))
+	}
+	for k, v := range forward {
+		if back, ok := inverse[v]; !ok || back != k {
+			return fmt.Errorf("forward %v -> %v is not mirrored by inverse", k, v)
+		}
+		if want, ok := model[k]; !ok || want != v {
+			return fmt.Errorf("key %v maps to %v, want %v (present: %t)", k, v, want, ok)
+		}
+	}
+	return nil
+}
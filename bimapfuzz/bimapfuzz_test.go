@@ -0,0 +1,50 @@
+package bimapfuzz
+
+import (
+	"testing"
+
+	"github.com/adrianlungu/bimap"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyOps(t *testing.T) {
+	ops := []byte{
+		opInsert, 1, 10,
+		opInsert, 2, 20,
+		opInsert, 1, 11,
+		opInsert, 3, 20,
+		opDeleteByValue, 0, 20,
+		opDeleteByKey, 1, 0,
+		opInsert, 5, 50,
+		opRenameKey, 5, 6,
+		opRenameKey, 9, 1,
+		opInsert, 7, 70,
+		opRenameKey, 6, 7,
+		opMerge, 8, 80,
+		opMerge, 8, 81,
+		opMerge, 9, 81,
+		opInsert, 4,
+	}
+	assert.NoError(t, ApplyOps(ops))
+}
+
+func TestCheck(t *testing.T) {
+	b := bimap.NewBiMap[byte, byte]()
+	b.Insert(1, 10)
+
+	assert.NoError(t, Check(b, map[byte]byte{1: 10}))
+	assert.Error(t, Check(b, map[byte]byte{1: 11}), "It should report a mismatch with the model")
+
+	b.Insert(2, 10)
+	assert.Error(t, Check(b, map[byte]byte{2: 10}), "It should report a dangling forward entry")
+}
+
+func FuzzApplyOps(f *testing.F) {
+	f.Add([]byte{opInsert, 1, 1, opInsert, 2, 2, opDeleteByKey, 1, 0})
+	f.Add([]byte{opInsert, 1, 1, opInsert, 1, 2, opDeleteByValue, 0, 2})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := ApplyOps(data); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
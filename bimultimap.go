@@ -0,0 +1,123 @@
+package bimap
+
+import (
+	"maps"
+	"slices"
+	"sync"
+)
+
+// BiMultiMap is a thread safe many-to-many relation indexed in both directions, such as users and their groups.
+// It stores a set of key-value pairs; each key maps to a set of values and each value to a set of keys.
+type BiMultiMap[K comparable, V comparable] struct {
+	s       sync.RWMutex
+	forward map[K]map[V]struct{}
+	inverse map[V]map[K]struct{}
+	size    int
+}
+
+// NewBiMultiMap returns an empty BiMultiMap.
+func NewBiMultiMap[K comparable, V comparable]() *BiMultiMap[K, V] {
+	return &BiMultiMap[K, V]{forward: make(map[K]map[V]struct{}), inverse: make(map[V]map[K]struct{})}
+}
+
+// Add relates k and v. It reports whether the pair was added, as opposed to already present.
+func (b *BiMultiMap[K, V]) Add(k K, v V) bool {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if _, ok := b.forward[k][v]; ok {
+		return false
+	}
+	addToSet(b.forward, k, v)
+	addToSet(b.inverse, v, k)
+	b.size++
+	return true
+}
+
+// Remove unrelates k and v. It reports whether the pair was present.
+func (b *BiMultiMap[K, V]) Remove(k K, v V) bool {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if _, ok := b.forward[k][v]; !ok {
+		return false
+	}
+	removeFromSet(b.forward, k, v)
+	removeFromSet(b.inverse, v, k)
+	b.size--
+	return true
+}
+
+// Contains reports whether k and v are related.
+func (b *BiMultiMap[K, V]) Contains(k K, v V) bool {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	_, ok := b.forward[k][v]
+	return ok
+}
+
+// GetValuesByKey returns a copy of the values related to k, in no particular order, or nil if there are none.
+func (b *BiMultiMap[K, V]) GetValuesByKey(k K) []V {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	if values, ok := b.forward[k]; ok {
+		return slices.Collect(maps.Keys(values))
+	}
+	return nil
+}
+
+// GetKeysByValue returns a copy of the keys related to v, in no particular order, or nil if there are none.
+func (b *BiMultiMap[K, V]) GetKeysByValue(v V) []K {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	if keys, ok := b.inverse[v]; ok {
+		return slices.Collect(maps.Keys(keys))
+	}
+	return nil
+}
+
+// DeleteByKey removes every pair with key k. Returns if the key doesn't exist.
+func (b *BiMultiMap[K, V]) DeleteByKey(k K) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	for v := range b.forward[k] {
+		removeFromSet(b.inverse, v, k)
+		b.size--
+	}
+	delete(b.forward, k)
+}
+
+// DeleteByValue removes every pair with value v. Returns if the value doesn't exist.
+func (b *BiMultiMap[K, V]) DeleteByValue(v V) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	for k := range b.inverse[v] {
+		removeFromSet(b.forward, k, v)
+		b.size--
+	}
+	delete(b.inverse, v)
+}
+
+// Size returns the number of key-value pairs in the BiMultiMap.
+func (b *BiMultiMap[K, V]) Size() int {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	return b.size
+}
+
+// addToSet adds b to a's set, creating the set if needed.
+func addToSet[A comparable, B comparable](index map[A]map[B]struct{}, a A, b B) {
+	set, ok := index[a]
+	if !ok {
+		set = make(map[B]struct{})
+		index[a] = set
+	}
+	set[b] = struct{}{}
+}
+
+// removeFromSet removes b from a's set, dropping a once its set is empty.
+func removeFromSet[A comparable, B comparable](index map[A]map[B]struct{}, a A, b B) {
+	set := index[a]
+	delete(set, b)
+	if len(set) == 0 {
+		delete(index, a)
+	}
+}
@@ -0,0 +1,44 @@
+package bimap
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMultiMap(t *testing.T) {
+	actual := NewBiMultiMap[string, string]()
+	assert.True(t, actual.Add("ann", "admins"))
+	assert.True(t, actual.Add("ann", "devs"))
+	assert.True(t, actual.Add("bob", "devs"))
+	assert.False(t, actual.Add("bob", "devs"), "Adding an existing pair should report false")
+
+	assert.Equal(t, 3, actual.Size())
+	assert.Equal(t, []string{"admins", "devs"}, slices.Sorted(slices.Values(actual.GetValuesByKey("ann"))))
+	assert.Equal(t, []string{"ann", "bob"}, slices.Sorted(slices.Values(actual.GetKeysByValue("devs"))))
+	assert.Nil(t, actual.GetKeysByValue("ops"))
+	assert.True(t, actual.Contains("bob", "devs"))
+
+	assert.True(t, actual.Remove("ann", "admins"))
+	assert.False(t, actual.Remove("ann", "admins"))
+	assert.Nil(t, actual.GetKeysByValue("admins"), "Values without keys should be removed")
+
+	actual.DeleteByValue("devs")
+	assert.Nil(t, actual.GetValuesByKey("ann"))
+	assert.Nil(t, actual.GetValuesByKey("bob"))
+	assert.Equal(t, 0, actual.Size())
+}
+
+func TestBiMultiMap_DeleteByKey(t *testing.T) {
+	actual := NewBiMultiMap[string, int]()
+	actual.Add("a", 1)
+	actual.Add("a", 2)
+	actual.Add("b", 2)
+
+	actual.DeleteByKey("a")
+	actual.DeleteByKey("missing")
+	assert.Equal(t, 1, actual.Size())
+	assert.Nil(t, actual.GetKeysByValue(1))
+	assert.Equal(t, []string{"b"}, actual.GetKeysByValue(2))
+}
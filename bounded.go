@@ -0,0 +1,142 @@
+package bimap
+
+import (
+	"container/list"
+	"sync"
+)
+
+// BoundedBiMap is a thread safe bidirectional map holding at most a fixed number of entries. When an insert would
+// exceed the capacity, the least recently used pair is evicted from both directions. Inserts and lookups in either
+// direction count as uses. Like SortedBiMap, Insert removes any existing pairing of the value so the map stays a bijection.
+type BoundedBiMap[K comparable, V comparable] struct {
+	s        sync.Mutex
+	capacity int
+	order    *list.List // of Pair[K, V], most recently used first
+	byKey    map[K]*list.Element
+	byValue  map[V]*list.Element
+	onEvict  func(K, V)
+}
+
+// NewBoundedBiMap returns an empty BoundedBiMap holding at most capacity entries. It panics if capacity is not positive.
+func NewBoundedBiMap[K comparable, V comparable](capacity int) *BoundedBiMap[K, V] {
+	if capacity <= 0 {
+		panic("bimap: BoundedBiMap capacity must be positive")
+	}
+	return &BoundedBiMap[K, V]{
+		capacity: capacity,
+		order:    list.New(),
+		byKey:    make(map[K]*list.Element, capacity),
+		byValue:  make(map[V]*list.Element, capacity),
+	}
+}
+
+// OnEvict registers fn to be called with each pair evicted to make room, replacing any previous callback. It is not
+// called for explicit deletes. Like change listeners, fn runs under the lock and must not call methods on the BoundedBiMap.
+func (b *BoundedBiMap[K, V]) OnEvict(fn func(k K, v V)) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	b.onEvict = fn
+}
+
+// Insert puts a key and value into the BoundedBiMap as its most recently used pair, evicting the least recently used
+// pair if the BoundedBiMap is full. Any existing pairing of k or of v is removed so the map stays a bijection.
+func (b *BoundedBiMap[K, V]) Insert(k K, v V) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if e, ok := b.byKey[k]; ok {
+		b.remove(e)
+	}
+	if e, ok := b.byValue[v]; ok {
+		b.remove(e)
+	}
+	if b.order.Len() >= b.capacity {
+		oldest := b.order.Back()
+		b.remove(oldest)
+		if b.onEvict != nil {
+			p := oldest.Value.(Pair[K, V])
+			b.onEvict(p.Key, p.Value)
+		}
+	}
+	e := b.order.PushFront(Pair[K, V]{Key: k, Value: v})
+	b.byKey[k] = e
+	b.byValue[v] = e
+}
+
+// GetByKey returns the value for a given key and whether or not the element was present, marking the pair as used.
+func (b *BoundedBiMap[K, V]) GetByKey(k K) (V, bool) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	e, ok := b.byKey[k]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	b.order.MoveToFront(e)
+	return e.Value.(Pair[K, V]).Value, true
+}
+
+// GetByValue returns the key for a given value and whether or not the element was present, marking the pair as used.
+func (b *BoundedBiMap[K, V]) GetByValue(v V) (K, bool) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	e, ok := b.byValue[v]
+	if !ok {
+		var zero K
+		return zero, false
+	}
+	b.order.MoveToFront(e)
+	return e.Value.(Pair[K, V]).Key, true
+}
+
+// ExistsByKey checks whether or not a key exists in the BoundedBiMap, without marking it as used.
+func (b *BoundedBiMap[K, V]) ExistsByKey(k K) bool {
+	b.s.Lock()
+	defer b.s.Unlock()
+	_, ok := b.byKey[k]
+	return ok
+}
+
+// ExistsByValue checks whether or not a value exists in the BoundedBiMap, without marking it as used.
+func (b *BoundedBiMap[K, V]) ExistsByValue(v V) bool {
+	b.s.Lock()
+	defer b.s.Unlock()
+	_, ok := b.byValue[v]
+	return ok
+}
+
+// DeleteByKey removes a key-value pair from the BoundedBiMap for a given key. Returns if the key doesn't exist.
+func (b *BoundedBiMap[K, V]) DeleteByKey(k K) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if e, ok := b.byKey[k]; ok {
+		b.remove(e)
+	}
+}
+
+// DeleteByValue removes a key-value pair from the BoundedBiMap for a given value. Returns if the value doesn't exist.
+func (b *BoundedBiMap[K, V]) DeleteByValue(v V) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if e, ok := b.byValue[v]; ok {
+		b.remove(e)
+	}
+}
+
+// Size returns the number of elements in the BoundedBiMap.
+func (b *BoundedBiMap[K, V]) Size() int {
+	b.s.Lock()
+	defer b.s.Unlock()
+	return b.order.Len()
+}
+
+// Capacity returns the maximum number of elements the BoundedBiMap holds.
+func (b *BoundedBiMap[K, V]) Capacity() int {
+	return b.capacity
+}
+
+// remove unlinks e from all indexes. The caller must hold the lock.
+func (b *BoundedBiMap[K, V]) remove(e *list.Element) {
+	p := b.order.Remove(e).(Pair[K, V])
+	delete(b.byKey, p.Key)
+	delete(b.byValue, p.Value)
+}
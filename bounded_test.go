@@ -0,0 +1,53 @@
+package bimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoundedBiMap(t *testing.T) {
+	actual := NewBoundedBiMap[string, int](2)
+	var evicted []string
+	actual.OnEvict(func(k string, v int) { evicted = append(evicted, k) })
+
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+	actual.GetByValue(1) // a is now the most recently used
+	actual.Insert("c", 3)
+
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.Equal(t, 2, actual.Size())
+	assert.False(t, actual.ExistsByKey("b"))
+	assert.False(t, actual.ExistsByValue(2), "Evicted pairs should be removed from both directions")
+
+	actual.GetByKey("c")
+	actual.Insert("d", 4)
+	assert.Equal(t, []string{"b", "a"}, evicted)
+}
+
+func TestBoundedBiMap_Bijection(t *testing.T) {
+	actual := NewBoundedBiMap[string, int](2)
+	var evicted []string
+	actual.OnEvict(func(k string, v int) { evicted = append(evicted, k) })
+
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+	actual.Insert("c", 1)
+	actual.Insert("b", 20)
+
+	assert.Empty(t, evicted, "Replacing a pairing should not evict")
+	assert.False(t, actual.ExistsByKey("a"), "Previous owner of the value should be removed")
+	v, _ := actual.GetByKey("b")
+	assert.Equal(t, 20, v)
+	assert.False(t, actual.ExistsByValue(2))
+
+	actual.DeleteByKey("b")
+	actual.DeleteByValue(1)
+	assert.Equal(t, 0, actual.Size())
+	assert.Empty(t, evicted, "Deletes should not count as evictions")
+}
+
+func TestBoundedBiMap_InvalidCapacity(t *testing.T) {
+	assert.Panics(t, func() { NewBoundedBiMap[string, int](0) })
+}
@@ -0,0 +1,59 @@
+package bimap
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Builder accumulates pairs for an ImmutableBiMap, deferring duplicate detection to Build so that every conflict is
+// reported at once and the result never depends on map iteration order. A Builder is not safe for concurrent use.
+type Builder[K comparable, V comparable] struct {
+	pairs []Pair[K, V]
+}
+
+// NewImmutableBiMapBuilder returns an empty Builder.
+func NewImmutableBiMapBuilder[K comparable, V comparable]() *Builder[K, V] {
+	return &Builder[K, V]{}
+}
+
+// Put adds the pair k, v and returns the Builder for chaining.
+func (b *Builder[K, V]) Put(k K, v V) *Builder[K, V] {
+	b.pairs = append(b.pairs, Pair[K, V]{Key: k, Value: v})
+	return b
+}
+
+// PutAll adds every pair of m and returns the Builder for chaining.
+func (b *Builder[K, V]) PutAll(m map[K]V) *Builder[K, V] {
+	for k, v := range m {
+		b.Put(k, v)
+	}
+	return b
+}
+
+// Build returns an ImmutableBiMap holding the pairs put so far. Putting the same pair twice is harmless, but if a key
+// was put with two values or a value with two keys, Build returns an error joining one error per conflict, in the
+// order the conflicting pairs were put, each wrapping ErrDuplicateKey or ErrDuplicateValue. The Builder can be reused.
+func (b *Builder[K, V]) Build() (*ImmutableBiMap[K, V], error) {
+	forward := make(map[K]V, len(b.pairs))
+	inverse := make(map[V]K, len(b.pairs))
+	var errs []error
+	for _, p := range b.pairs {
+		v, keyTaken := forward[p.Key]
+		k, valueTaken := inverse[p.Value]
+		switch {
+		case keyTaken && v == p.Value:
+			continue
+		case keyTaken:
+			errs = append(errs, fmt.Errorf("%w: %v put with both %v and %v", ErrDuplicateKey, p.Key, v, p.Value))
+		case valueTaken:
+			errs = append(errs, fmt.Errorf("%w: %v put with both %v and %v", ErrDuplicateValue, p.Value, k, p.Key))
+		default:
+			forward[p.Key] = p.Value
+			inverse[p.Value] = p.Key
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return &ImmutableBiMap[K, V]{forward: forward, inverse: inverse}, nil
+}
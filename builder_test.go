@@ -0,0 +1,32 @@
+package bimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder(t *testing.T) {
+	actual, err := NewImmutableBiMapBuilder[string, int]().
+		Put("a", 1).
+		Put("b", 2).
+		Put("a", 1).
+		PutAll(map[string]int{"c": 3}).
+		Build()
+	assert.NoError(t, err, "Repeating a pair should be harmless")
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3}, actual.GetForwardMap())
+}
+
+func TestBuilder_Duplicates(t *testing.T) {
+	builder := NewImmutableBiMapBuilder[string, int]().
+		Put("a", 1).
+		Put("a", 2).
+		Put("b", 1).
+		Put("c", 3)
+
+	_, err := builder.Build()
+	assert.ErrorIs(t, err, ErrDuplicateKey)
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+	assert.EqualError(t, err, "bimap: duplicate key: a put with both 1 and 2\nbimap: duplicate value: 1 put with both a and b",
+		"Every conflict should be listed in put order")
+}
@@ -0,0 +1,57 @@
+package bimap
+
+// GetByKeyBytes returns the value for the string key held in k without allocating a string for the lookup.
+// Maps built with normalization or collation options fall back to an allocating lookup.
+func GetByKeyBytes[V comparable](b *BiMap[string, V], k []byte) (V, bool) {
+	b.rlock()
+	defer b.runlock()
+	var v V
+	var ok bool
+	if b.normKey != nil || b.fold != nil {
+		v, ok = b.forward[b.resolveKey(string(k))]
+	} else {
+		v, ok = b.forward[string(k)]
+	}
+	b.recordLookup(false, ok)
+	return v, ok
+}
+
+// GetByValueBytes returns the key for the string value held in v without allocating a string for the lookup.
+// Maps built with normalization options fall back to an allocating lookup.
+func GetByValueBytes[K comparable](b *BiMap[K, string], v []byte) (K, bool) {
+	b.rlock()
+	defer b.runlock()
+	var k K
+	var ok bool
+	if b.normValue != nil {
+		k, ok = b.inverse[b.resolveValue(string(v))]
+	} else {
+		k, ok = b.inverse[string(v)]
+	}
+	b.recordLookup(true, ok)
+	return k, ok
+}
+
+// ExistsByKeyBytes checks whether the string key held in k exists without allocating a string for the lookup.
+func ExistsByKeyBytes[V comparable](b *BiMap[string, V], k []byte) bool {
+	_, ok := GetByKeyBytes(b, k)
+	return ok
+}
+
+// ExistsByValueBytes checks whether the string value held in v exists without allocating a string for the lookup.
+func ExistsByValueBytes[K comparable](b *BiMap[K, string], v []byte) bool {
+	_, ok := GetByValueBytes(b, v)
+	return ok
+}
+
+// ImmutableGetByKeyBytes returns the value for the string key held in k without allocating a string for the lookup.
+func ImmutableGetByKeyBytes[V comparable](b *ImmutableBiMap[string, V], k []byte) (V, bool) {
+	v, ok := b.forward[string(k)]
+	return v, ok
+}
+
+// ImmutableGetByValueBytes returns the key for the string value held in v without allocating a string for the lookup.
+func ImmutableGetByValueBytes[K comparable](b *ImmutableBiMap[K, string], v []byte) (K, bool) {
+	k, ok := b.inverse[string(v)]
+	return k, ok
+}
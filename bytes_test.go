@@ -0,0 +1,64 @@
+package bimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestGetByKeyBytes(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	actual.Insert(key, 1)
+	buf := []byte(key)
+
+	v, ok := GetByKeyBytes(actual, buf)
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.True(t, ExistsByKeyBytes(actual, buf))
+	assert.False(t, ExistsByKeyBytes(actual, []byte("missing")))
+
+	allocs := testing.AllocsPerRun(100, func() { GetByKeyBytes(actual, buf) })
+	assert.Zero(t, allocs, "Lookup should not allocate")
+}
+
+func TestGetByValueBytes(t *testing.T) {
+	actual := NewBiMap[int, string]()
+	actual.Insert(1, value)
+
+	k, ok := GetByValueBytes(actual, []byte(value))
+	assert.True(t, ok)
+	assert.Equal(t, 1, k)
+	assert.False(t, ExistsByValueBytes(actual, []byte("missing")))
+}
+
+func TestGetByKeyBytes_Normalized(t *testing.T) {
+	actual := NewBiMap[string, int](WithUnicodeNormalization(norm.NFC))
+	actual.Insert("cafe\u0301", 1)
+
+	v, ok := GetByKeyBytes(actual, []byte("café"))
+	assert.True(t, ok, "Normalization should still apply to byte lookups")
+	assert.Equal(t, 1, v)
+}
+
+func TestGetBytes_LookupStats(t *testing.T) {
+	actual := NewBiMap[string, string](WithLookupStats())
+	actual.Insert("a", "b")
+
+	GetByKeyBytes(actual, []byte("a"))
+	GetByKeyBytes(actual, []byte("missing"))
+	GetByValueBytes(actual, []byte("b"))
+	assert.Equal(t, LookupStats{ForwardHits: 1, ForwardMisses: 1, InverseHits: 1}, *actual.Stats().Lookups)
+}
+
+func TestImmutableGetBytes(t *testing.T) {
+	m := NewImmutableBiMapFromMap(map[string]string{key: value})
+
+	v, ok := ImmutableGetByKeyBytes(m, []byte(key))
+	assert.True(t, ok)
+	assert.Equal(t, value, v)
+
+	k, ok := ImmutableGetByValueBytes(m, []byte(value))
+	assert.True(t, ok)
+	assert.Equal(t, key, k)
+}
@@ -0,0 +1,91 @@
+package bimap
+
+import (
+	"context"
+	"time"
+)
+
+// RepairPolicy controls what StartConsistencyChecker does when it finds forward/inverse asymmetries.
+type RepairPolicy struct {
+	// Repair removes dangling entries: forward entries whose value maps back to a different key,
+	// and inverse entries whose key does not map to them. This keeps the most recent pairing of each value.
+	// Immutable maps are never repaired.
+	Repair bool
+	// OnInconsistency, if set, is called with the report of every scan that finds problems, before any repair.
+	OnInconsistency func(HealthReport)
+}
+
+// StartConsistencyChecker scans the whole BiMap every interval in a background goroutine and applies policy
+// to any asymmetries found. It stops when ctx is done.
+func (b *BiMap[K, V]) StartConsistencyChecker(ctx context.Context, interval time.Duration, policy RepairPolicy) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				b.checkConsistency(policy)
+			}
+		}
+	}()
+}
+
+// checkConsistency runs a single full scan and applies policy.
+func (b *BiMap[K, V]) checkConsistency(policy RepairPolicy) {
+	b.rlock()
+	report := HealthReport{Size: len(b.forward), InverseSize: len(b.inverse), Problems: b.asymmetries(0)}
+	if b.lastMutation != nil {
+		report.LastMutation = *b.lastMutation
+	}
+	b.runlock()
+	if report.Healthy() {
+		return
+	}
+	if policy.OnInconsistency != nil {
+		policy.OnInconsistency(report)
+	}
+	if policy.Repair {
+		b.lock()
+		if !b.immutable {
+			b.repair()
+		}
+		b.unlock()
+	}
+}
+
+// repair removes dangling entries from both indexes and returns how many were removed. Entries that a lookup could
+// return are removed as DeleteByKey would, so audit logs, listeners and metrics see them go. The caller must hold the
+// lock.
+func (b *BiMap[K, V]) repair() int {
+	ctx := context.Background()
+	removed := 0
+	for k, v := range b.forward {
+		back, ok := b.inverse[v]
+		if ok && back == k {
+			continue
+		}
+		b.deletePair(ctx, k, v)
+		if ok {
+			// v belongs to another key's pair, which keeps its inverse entry.
+			b.inverse[v] = back
+		}
+		removed++
+	}
+	for v, k := range b.inverse {
+		fwd, ok := b.forward[k]
+		switch {
+		case ok && fwd == v:
+			continue
+		case ok:
+			// k belongs to another pair, so the stale entry is dropped without reporting k as deleted.
+			delete(b.inverse, v)
+			b.touch()
+		default:
+			b.deletePair(ctx, k, v)
+		}
+		removed++
+	}
+	return removed
+}
@@ -0,0 +1,72 @@
+package bimap
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap_StartConsistencyChecker_Report(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	actual.Insert("a", 1)
+	actual.Insert("b", 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reports := make(chan HealthReport, 1)
+	actual.StartConsistencyChecker(ctx, time.Millisecond, RepairPolicy{OnInconsistency: func(r HealthReport) {
+		select {
+		case reports <- r:
+		default:
+		}
+	}})
+
+	select {
+	case r := <-reports:
+		assert.False(t, r.Healthy())
+	case <-time.After(time.Second):
+		t.Fatal("Inconsistency was not reported")
+	}
+	assert.Equal(t, 2, actual.Size(), "Report-only policy should not modify the map")
+}
+
+func TestBiMap_StartConsistencyChecker_Repair(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	actual.Insert("a", 1)
+	actual.Insert("b", 1)
+	actual.Insert("c", 3)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	actual.StartConsistencyChecker(ctx, time.Millisecond, RepairPolicy{Repair: true})
+
+	assert.Eventually(t, func() bool { return actual.HealthCheck() == nil }, time.Second, time.Millisecond)
+	assert.False(t, actual.ExistsByKey("a"), "Dangling key should be removed")
+	k, _ := actual.GetByValue(1)
+	assert.Equal(t, "b", k, "Most recent pairing should be kept")
+	assert.True(t, actual.ExistsByKey("c"))
+}
+
+func TestBiMap_Repair_Observed(t *testing.T) {
+	metrics := &countingCollector{}
+	actual := NewBiMap[string, int](WithAuditLog(), WithMetrics(metrics))
+	actual.Insert("a", 1)
+	actual.Insert("b", 1)
+	var deleted []string
+	actual.OnDelete(func(k string, _ int) { deleted = append(deleted, k) })
+
+	actual.lock()
+	assert.Equal(t, 1, actual.repair())
+	actual.unlock()
+	assert.NoError(t, actual.HealthCheck())
+	assert.Equal(t, []string{"a"}, deleted, "Listeners should see the dangling pair removed")
+	if log := actual.AuditLog("a"); assert.NotEmpty(t, log) {
+		assert.Equal(t, AuditDelete, log[len(log)-1].Op)
+	}
+	assert.Equal(t, 1, metrics.deletes)
+	assert.Equal(t, 1, metrics.size, "The Collector should see the repaired size")
+	k, _ := actual.GetByValue(1)
+	assert.Equal(t, "b", k, "The surviving pair should keep its inverse entry")
+}
@@ -0,0 +1,205 @@
+package bimap
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+)
+
+// snapshotVersion identifies the layout of the binary snapshot format.
+const snapshotVersion = 1
+
+// snapshot is the binary encoding of a bimap: parallel key and value slices, encoded with gob.
+type snapshot[K comparable, V comparable] struct {
+	Version int
+	Keys    []K
+	Values  []V
+}
+
+// MarshalJSON encodes the BiMap as a JSON object of its key-value pairs.
+// Keys must be strings, integers or implement encoding.TextMarshaler.
+func (b *BiMap[K, V]) MarshalJSON() ([]byte, error) {
+	b.rlock()
+	defer b.runlock()
+	return json.Marshal(b.forward)
+}
+
+// UnmarshalJSON decodes a JSON object into the BiMap, replacing its contents as if by Clear followed by TryInsert of
+// each pair, so the BiMap's normalization, validators and size limit apply. It returns ErrDuplicateKey or
+// ErrDuplicateValue if two pairs collide, ErrImmutable if the BiMap is immutable, or the first insert error, leaving
+// the BiMap unchanged.
+func (b *BiMap[K, V]) UnmarshalJSON(data []byte) error {
+	var forward map[K]V
+	if err := json.Unmarshal(data, &forward); err != nil {
+		return err
+	}
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return ErrImmutable
+	}
+	return b.replaceContents(forward)
+}
+
+// replaceContents replaces the BiMap's pairs with those of forward as if by Clear followed by TryInsert of each pair,
+// so keys and values are normalized, validated, counted against the size limit and audited. If a pair is rejected,
+// or two pairs collide once normalized, it returns the error and leaves the BiMap unchanged. The caller must hold the
+// write lock and have checked mutability.
+func (b *BiMap[K, V]) replaceContents(forward map[K]V) error {
+	next := b.derive(make(map[K]V, len(forward)), make(map[V]K, len(forward)))
+	for k, v := range forward {
+		rk, rv := next.resolveKey(k), next.resolveValue(v)
+		if _, ok := next.forward[rk]; ok {
+			return fmt.Errorf("%w: %v", ErrDuplicateKey, rk)
+		}
+		if other, ok := next.inverse[rv]; ok {
+			return fmt.Errorf("%w: %v held by both %v and %v", ErrDuplicateValue, rv, other, rk)
+		}
+		if err := next.insertResolved(context.Background(), rk, rv); err != nil {
+			return err
+		}
+	}
+	b.clearLocked()
+	if b.forward == nil {
+		b.forward, b.inverse = make(map[K]V, len(next.forward)), make(map[V]K, len(next.forward))
+	}
+	clear(b.forward)
+	clear(b.inverse)
+	if b.fold != nil {
+		clear(b.folded)
+	}
+	for k, v := range next.forward {
+		_ = b.insertResolved(context.Background(), k, v)
+	}
+	b.touch()
+	return nil
+}
+
+// MarshalBinary encodes the BiMap in the binary snapshot format.
+func (b *BiMap[K, V]) MarshalBinary() ([]byte, error) {
+	b.rlock()
+	defer b.runlock()
+	return encodeSnapshot(b.forward)
+}
+
+// UnmarshalBinary decodes a binary snapshot into the BiMap, replacing its contents as UnmarshalJSON does. It returns
+// ErrDuplicateKey or ErrDuplicateValue if the snapshot does not describe a bijection once normalized, ErrImmutable if
+// the BiMap is immutable, or the first insert error, leaving the BiMap unchanged.
+func (b *BiMap[K, V]) UnmarshalBinary(data []byte) error {
+	forward, _, err := decodeSnapshot[K, V](data)
+	if err != nil {
+		return err
+	}
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return ErrImmutable
+	}
+	return b.replaceContents(forward)
+}
+
+// MarshalJSON encodes the ImmutableBiMap as a JSON object of its key-value pairs.
+// Keys must be strings, integers or implement encoding.TextMarshaler.
+func (b *ImmutableBiMap[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.forward)
+}
+
+// UnmarshalJSON decodes a JSON object into an empty ImmutableBiMap, such as a zero value being filled in by
+// json.Unmarshal. It returns ErrImmutable if the ImmutableBiMap already holds pairs, which may be being read
+// concurrently, and ErrDuplicateValue if two keys map to the same value.
+func (b *ImmutableBiMap[K, V]) UnmarshalJSON(data []byte) error {
+	if err := b.checkDecodable(); err != nil {
+		return err
+	}
+	var forward map[K]V
+	if err := json.Unmarshal(data, &forward); err != nil {
+		return err
+	}
+	inverse, err := invert(forward)
+	if err != nil {
+		return err
+	}
+	b.forward, b.inverse = forward, inverse
+	return nil
+}
+
+// MarshalBinary encodes the ImmutableBiMap in the binary snapshot format.
+func (b *ImmutableBiMap[K, V]) MarshalBinary() ([]byte, error) {
+	return encodeSnapshot(b.forward)
+}
+
+// UnmarshalBinary decodes a binary snapshot into an empty ImmutableBiMap. It returns ErrImmutable if the
+// ImmutableBiMap already holds pairs and ErrDuplicateKey or ErrDuplicateValue if the snapshot does not describe a
+// bijection.
+func (b *ImmutableBiMap[K, V]) UnmarshalBinary(data []byte) error {
+	if err := b.checkDecodable(); err != nil {
+		return err
+	}
+	forward, inverse, err := decodeSnapshot[K, V](data)
+	if err != nil {
+		return err
+	}
+	b.forward, b.inverse = forward, inverse
+	return nil
+}
+
+// checkDecodable refuses to decode into an ImmutableBiMap that already holds pairs, since replacing them would race
+// with readers relying on its immutability.
+func (b *ImmutableBiMap[K, V]) checkDecodable() error {
+	if len(b.forward) > 0 {
+		return fmt.Errorf("%w: cannot decode into an ImmutableBiMap holding %d pairs", ErrImmutable, len(b.forward))
+	}
+	return nil
+}
+
+// invert builds the inverse of forward, failing if two keys share a value.
+func invert[K comparable, V comparable](forward map[K]V) (map[V]K, error) {
+	inverse := make(map[V]K, len(forward))
+	for k, v := range forward {
+		if other, ok := inverse[v]; ok {
+			return nil, fmt.Errorf("%w: %v held by both %v and %v", ErrDuplicateValue, v, other, k)
+		}
+		inverse[v] = k
+	}
+	return inverse, nil
+}
+
+func encodeSnapshot[K comparable, V comparable](forward map[K]V) ([]byte, error) {
+	s := snapshot[K, V]{Version: snapshotVersion, Keys: make([]K, 0, len(forward)), Values: make([]V, 0, len(forward))}
+	for k, v := range forward {
+		s.Keys = append(s.Keys, k)
+		s.Values = append(s.Values, v)
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeSnapshot[K comparable, V comparable](data []byte) (map[K]V, map[V]K, error) {
+	var s snapshot[K, V]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&s); err != nil {
+		return nil, nil, err
+	}
+	if s.Version != snapshotVersion {
+		return nil, nil, fmt.Errorf("bimap: unsupported snapshot version %d", s.Version)
+	}
+	if len(s.Keys) != len(s.Values) {
+		return nil, nil, fmt.Errorf("bimap: snapshot has %d keys but %d values", len(s.Keys), len(s.Values))
+	}
+	forward := make(map[K]V, len(s.Keys))
+	for i, k := range s.Keys {
+		if _, ok := forward[k]; ok {
+			return nil, nil, fmt.Errorf("%w: %v", ErrDuplicateKey, k)
+		}
+		forward[k] = s.Values[i]
+	}
+	inverse, err := invert(forward)
+	if err != nil {
+		return nil, nil, err
+	}
+	return forward, inverse, nil
+}
@@ -0,0 +1,165 @@
+package bimap
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImmutableBiMap_JSON(t *testing.T) {
+	m := NewImmutableBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"a":1,"b":2}`, string(data))
+
+	var decoded ImmutableBiMap[string, int]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, m.GetForwardMap(), decoded.GetForwardMap())
+	k, _ := decoded.GetByValue(2)
+	assert.Equal(t, "b", k, "Inverse index should be rebuilt")
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"c":3}`), &decoded), ErrImmutable, "A populated ImmutableBiMap should not be overwritten")
+	assert.Equal(t, m.GetForwardMap(), decoded.GetForwardMap())
+}
+
+func TestImmutableBiMap_JSONDuplicateValue(t *testing.T) {
+	var decoded ImmutableBiMap[string, int]
+	err := json.Unmarshal([]byte(`{"a":1,"b":1}`), &decoded)
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+}
+
+func TestImmutableBiMap_Binary(t *testing.T) {
+	m := NewImmutableBiMapFromMap(map[int]string{1: "one", 2: "two"})
+
+	data, err := m.MarshalBinary()
+	assert.NoError(t, err)
+
+	var decoded ImmutableBiMap[int, string]
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, m.GetForwardMap(), decoded.GetForwardMap())
+	assert.Equal(t, m.GetInverseMap(), decoded.GetInverseMap())
+	assert.ErrorIs(t, m.UnmarshalBinary(data), ErrImmutable, "A populated ImmutableBiMap should not be overwritten")
+}
+
+func TestBiMap_Binary(t *testing.T) {
+	m := NewBiMapFromMap(map[int]string{1: "one", 2: "two"})
+
+	data, err := m.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := NewBiMapFromMap(map[int]string{3: "stale"})
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, m.SnapshotForward(), decoded.SnapshotForward())
+	assert.Equal(t, m.SnapshotInverse(), decoded.SnapshotInverse())
+
+	invalid, err := encodeSnapshotPairs([]int{1, 2}, []string{"x", "x"})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, decoded.UnmarshalBinary(invalid), ErrDuplicateValue)
+	assert.Equal(t, m.SnapshotForward(), decoded.SnapshotForward(), "Failed decode should leave the BiMap unchanged")
+}
+
+func TestBiMap_BinaryOptions(t *testing.T) {
+	source := NewBiMap[string, int]()
+	source.Insert("a", 1)
+	source.Insert("b", 2)
+	data, err := source.MarshalBinary()
+	assert.NoError(t, err)
+
+	limited := NewBiMap[string, int](WithMaxEntries(1))
+	assert.ErrorIs(t, limited.UnmarshalBinary(data), ErrQuotaExceeded)
+	assert.Equal(t, 0, limited.Size(), "A rejected snapshot should leave the BiMap unchanged")
+
+	upper := NewBiMap[string, int](WithKeyNormalizer(strings.ToUpper))
+	assert.NoError(t, upper.UnmarshalBinary(data))
+	assert.Equal(t, map[string]int{"A": 1, "B": 2}, upper.SnapshotForward())
+
+	upper.MakeImmutable()
+	assert.ErrorIs(t, upper.UnmarshalBinary(data), ErrImmutable)
+}
+
+func TestDecodeSnapshot_Invalid(t *testing.T) {
+	data, err := encodeSnapshotPairs([]int{1, 2}, []string{"x", "x"})
+	assert.NoError(t, err)
+	_, _, err = decodeSnapshot[int, string](data)
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+
+	data, err = encodeSnapshotPairs([]int{1, 1}, []string{"x", "y"})
+	assert.NoError(t, err)
+	_, _, err = decodeSnapshot[int, string](data)
+	assert.ErrorIs(t, err, ErrDuplicateKey)
+
+	_, _, err = decodeSnapshot[int, string]([]byte("garbage"))
+	assert.Error(t, err)
+}
+
+func encodeSnapshotPairs(keys []int, values []string) ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(snapshot[int, string]{Version: snapshotVersion, Keys: keys, Values: values})
+	return buf.Bytes(), err
+}
+
+func TestBiMap_JSON(t *testing.T) {
+	m := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"a":1,"b":2}`, string(data))
+
+	decoded := NewBiMap[string, int]()
+	decoded.Insert("stale", 9)
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, decoded.GetForwardMap())
+	assert.Equal(t, map[int]string{1: "a", 2: "b"}, decoded.GetInverseMap())
+}
+
+func TestBiMap_JSONEmbedded(t *testing.T) {
+	type config struct {
+		Codes *BiMap[string, int] `json:"codes"`
+	}
+
+	var c config
+	assert.NoError(t, json.Unmarshal([]byte(`{"codes":{"a":1}}`), &c))
+	k, ok := c.Codes.GetByValue(1)
+	assert.True(t, ok)
+	assert.Equal(t, "a", k)
+}
+
+func TestBiMap_JSONOptions(t *testing.T) {
+	decoded := NewBiMap[string, int](
+		WithKeyNormalizer(strings.ToLower),
+		WithMaxEntries(2),
+		WithKeyValidator(func(k string) error {
+			if strings.HasPrefix(k, "bad") {
+				return errors.New("reserved prefix")
+			}
+			return nil
+		}),
+	)
+	decoded.Insert("x", 9)
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"ABC":1,"badkey":2}`), decoded), ErrInvalidKey)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"a":1,"b":2,"c":3}`), decoded), ErrQuotaExceeded)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"A":1,"a":2}`), decoded), ErrDuplicateKey)
+	assert.Equal(t, map[string]int{"x": 9}, decoded.SnapshotForward(), "Rejected decodes should leave the BiMap unchanged")
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"ABC":1}`), decoded))
+	v, ok := decoded.GetByKey("AbC")
+	assert.True(t, ok, "Decoded keys should be normalized")
+	assert.Equal(t, 1, v)
+
+	decoded.MakeImmutable()
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"a":1}`), decoded), ErrImmutable)
+}
+
+func TestBiMap_JSONDuplicateValue(t *testing.T) {
+	decoded := NewBiMapFromMap(map[string]int{"x": 7})
+	err := json.Unmarshal([]byte(`{"a":1,"b":1}`), decoded)
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+	assert.Equal(t, map[string]int{"x": 7}, decoded.GetForwardMap(), "Failed decode should leave the BiMap unchanged")
+}
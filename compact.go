@@ -0,0 +1,185 @@
+package bimap
+
+import (
+	"iter"
+	"slices"
+	"sync"
+)
+
+// compactLimit is the number of entries a CompactBiMap stores in slices before promoting itself to maps.
+const compactLimit = 16
+
+// CompactBiMap is a thread safe bidirectional map for small tables, such as enums. Up to 16 entries are kept in two
+// parallel slices searched linearly, avoiding the allocations and per-entry overhead of two hash maps. Past that it
+// moves its entries into maps, and keeps using them even if it later shrinks.
+type CompactBiMap[K comparable, V comparable] struct {
+	s       sync.RWMutex
+	keys    []K
+	values  []V
+	forward map[K]V
+	inverse map[V]K
+}
+
+// NewCompactBiMap returns an empty CompactBiMap.
+func NewCompactBiMap[K comparable, V comparable]() *CompactBiMap[K, V] {
+	return &CompactBiMap[K, V]{}
+}
+
+// Insert puts a key and value into the CompactBiMap. Any existing pairing of k or of v is removed so the map stays a bijection.
+func (b *CompactBiMap[K, V]) Insert(k K, v V) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if b.forward != nil {
+		if old, ok := b.forward[k]; ok {
+			delete(b.inverse, old)
+		}
+		if owner, ok := b.inverse[v]; ok {
+			delete(b.forward, owner)
+		}
+		b.forward[k] = v
+		b.inverse[v] = k
+		return
+	}
+	if i := slices.Index(b.values, v); i >= 0 {
+		b.removeAt(i)
+	}
+	if i := slices.Index(b.keys, k); i >= 0 {
+		b.values[i] = v
+		return
+	}
+	if len(b.keys) == compactLimit {
+		b.promote()
+		b.forward[k] = v
+		b.inverse[v] = k
+		return
+	}
+	b.keys = append(b.keys, k)
+	b.values = append(b.values, v)
+}
+
+// GetByKey returns the value for a given key and whether or not the element was present.
+func (b *CompactBiMap[K, V]) GetByKey(k K) (V, bool) {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	if b.forward != nil {
+		v, ok := b.forward[k]
+		return v, ok
+	}
+	if i := slices.Index(b.keys, k); i >= 0 {
+		return b.values[i], true
+	}
+	var zero V
+	return zero, false
+}
+
+// GetByValue returns the key for a given value and whether or not the element was present.
+func (b *CompactBiMap[K, V]) GetByValue(v V) (K, bool) {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	if b.inverse != nil {
+		k, ok := b.inverse[v]
+		return k, ok
+	}
+	if i := slices.Index(b.values, v); i >= 0 {
+		return b.keys[i], true
+	}
+	var zero K
+	return zero, false
+}
+
+// ExistsByKey checks whether or not a key exists in the CompactBiMap.
+func (b *CompactBiMap[K, V]) ExistsByKey(k K) bool {
+	_, ok := b.GetByKey(k)
+	return ok
+}
+
+// ExistsByValue checks whether or not a value exists in the CompactBiMap.
+func (b *CompactBiMap[K, V]) ExistsByValue(v V) bool {
+	_, ok := b.GetByValue(v)
+	return ok
+}
+
+// DeleteByKey removes a key-value pair from the CompactBiMap for a given key. Returns if the key doesn't exist.
+func (b *CompactBiMap[K, V]) DeleteByKey(k K) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if b.forward != nil {
+		if v, ok := b.forward[k]; ok {
+			delete(b.forward, k)
+			delete(b.inverse, v)
+		}
+		return
+	}
+	if i := slices.Index(b.keys, k); i >= 0 {
+		b.removeAt(i)
+	}
+}
+
+// DeleteByValue removes a key-value pair from the CompactBiMap for a given value. Returns if the value doesn't exist.
+func (b *CompactBiMap[K, V]) DeleteByValue(v V) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if b.inverse != nil {
+		if k, ok := b.inverse[v]; ok {
+			delete(b.forward, k)
+			delete(b.inverse, v)
+		}
+		return
+	}
+	if i := slices.Index(b.values, v); i >= 0 {
+		b.removeAt(i)
+	}
+}
+
+// Size returns the number of elements in the CompactBiMap.
+func (b *CompactBiMap[K, V]) Size() int {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	if b.forward != nil {
+		return len(b.forward)
+	}
+	return len(b.keys)
+}
+
+// All returns an iterator over the CompactBiMap's key-value pairs, in no particular order.
+// The read lock is held for the duration of the loop, so the loop body must not modify the CompactBiMap.
+func (b *CompactBiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		b.s.RLock()
+		defer b.s.RUnlock()
+		if b.forward != nil {
+			for k, v := range b.forward {
+				if !yield(k, v) {
+					return
+				}
+			}
+			return
+		}
+		for i, k := range b.keys {
+			if !yield(k, b.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// removeAt removes the i-th pair by moving the last pair into its place. The caller must hold the write lock.
+func (b *CompactBiMap[K, V]) removeAt(i int) {
+	last := len(b.keys) - 1
+	b.keys[i], b.values[i] = b.keys[last], b.values[last]
+	var zeroK K
+	var zeroV V
+	b.keys[last], b.values[last] = zeroK, zeroV
+	b.keys, b.values = b.keys[:last], b.values[:last]
+}
+
+// promote moves the entries from the slices into maps. The caller must hold the write lock.
+func (b *CompactBiMap[K, V]) promote() {
+	b.forward = make(map[K]V, 2*compactLimit)
+	b.inverse = make(map[V]K, 2*compactLimit)
+	for i, k := range b.keys {
+		b.forward[k] = b.values[i]
+		b.inverse[b.values[i]] = k
+	}
+	b.keys, b.values = nil, nil
+}
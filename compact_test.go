@@ -0,0 +1,58 @@
+package bimap
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactBiMap(t *testing.T) {
+	actual := NewCompactBiMap[string, int]()
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+
+	assert.Equal(t, 2, actual.Size())
+	v, ok := actual.GetByKey("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	k, ok := actual.GetByValue(2)
+	assert.True(t, ok)
+	assert.Equal(t, "b", k)
+
+	actual.Insert("a", 10)
+	assert.False(t, actual.ExistsByValue(1), "Replaced value should be gone")
+	actual.Insert("c", 2)
+	assert.False(t, actual.ExistsByKey("b"), "Previous owner of the value should be removed")
+	assert.Equal(t, map[string]int{"a": 10, "c": 2}, maps.Collect(actual.All()))
+
+	actual.DeleteByKey("a")
+	actual.DeleteByValue(2)
+	actual.DeleteByKey("missing")
+	assert.Equal(t, 0, actual.Size())
+	assert.Nil(t, actual.forward, "Small maps should not allocate maps")
+}
+
+func TestCompactBiMap_Promote(t *testing.T) {
+	actual := NewCompactBiMap[int, int]()
+	want := make(map[int]int)
+	for i := range compactLimit {
+		actual.Insert(i, -i)
+		want[i] = -i
+	}
+	assert.Nil(t, actual.forward)
+
+	actual.Insert(compactLimit, -compactLimit)
+	want[compactLimit] = -compactLimit
+	assert.NotNil(t, actual.forward, "Growing past the limit should promote to maps")
+	assert.Equal(t, want, maps.Collect(actual.All()))
+
+	actual.Insert(100, 0)
+	assert.False(t, actual.ExistsByKey(0), "Bijection should hold after promotion")
+	actual.DeleteByValue(-1)
+	actual.DeleteByKey(2)
+	assert.Equal(t, compactLimit-1, actual.Size())
+	k, ok := actual.GetByValue(0)
+	assert.True(t, ok)
+	assert.Equal(t, 100, k)
+}
@@ -0,0 +1,109 @@
+package bimap
+
+import (
+	"iter"
+	"sync"
+	"sync/atomic"
+)
+
+// ConcurrentBiMap is a bidirectional map built on two sync.Maps for read-mostly workloads. Lookups take no lock and
+// scale across cores; writes are serialized and cost more than on a BiMap. A lookup racing a write may see the pair
+// in one direction before the other.
+type ConcurrentBiMap[K comparable, V comparable] struct {
+	writer  sync.Mutex
+	forward sync.Map
+	inverse sync.Map
+	size    atomic.Int64
+}
+
+// NewConcurrentBiMap returns an empty ConcurrentBiMap.
+func NewConcurrentBiMap[K comparable, V comparable]() *ConcurrentBiMap[K, V] {
+	return &ConcurrentBiMap[K, V]{}
+}
+
+// Insert puts a key and value into the ConcurrentBiMap. Any existing pairing of k or of v is removed so the map stays a bijection.
+func (b *ConcurrentBiMap[K, V]) Insert(k K, v V) {
+	b.writer.Lock()
+	defer b.writer.Unlock()
+	if old, ok := b.forward.Load(k); ok {
+		if old.(V) == v {
+			return
+		}
+		b.inverse.Delete(old)
+		b.size.Add(-1)
+	}
+	if owner, ok := b.inverse.Load(v); ok {
+		b.forward.Delete(owner)
+		b.size.Add(-1)
+	}
+	b.forward.Store(k, v)
+	b.inverse.Store(v, k)
+	b.size.Add(1)
+}
+
+// GetByKey returns the value for a given key and whether or not the element was present.
+func (b *ConcurrentBiMap[K, V]) GetByKey(k K) (V, bool) {
+	v, ok := b.forward.Load(k)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return v.(V), true
+}
+
+// GetByValue returns the key for a given value and whether or not the element was present.
+func (b *ConcurrentBiMap[K, V]) GetByValue(v V) (K, bool) {
+	k, ok := b.inverse.Load(v)
+	if !ok {
+		var zero K
+		return zero, false
+	}
+	return k.(K), true
+}
+
+// ExistsByKey checks whether or not a key exists in the ConcurrentBiMap.
+func (b *ConcurrentBiMap[K, V]) ExistsByKey(k K) bool {
+	_, ok := b.forward.Load(k)
+	return ok
+}
+
+// ExistsByValue checks whether or not a value exists in the ConcurrentBiMap.
+func (b *ConcurrentBiMap[K, V]) ExistsByValue(v V) bool {
+	_, ok := b.inverse.Load(v)
+	return ok
+}
+
+// DeleteByKey removes a key-value pair from the ConcurrentBiMap for a given key. Returns if the key doesn't exist.
+func (b *ConcurrentBiMap[K, V]) DeleteByKey(k K) {
+	b.writer.Lock()
+	defer b.writer.Unlock()
+	if v, ok := b.forward.LoadAndDelete(k); ok {
+		b.inverse.Delete(v)
+		b.size.Add(-1)
+	}
+}
+
+// DeleteByValue removes a key-value pair from the ConcurrentBiMap for a given value. Returns if the value doesn't exist.
+func (b *ConcurrentBiMap[K, V]) DeleteByValue(v V) {
+	b.writer.Lock()
+	defer b.writer.Unlock()
+	if k, ok := b.inverse.LoadAndDelete(v); ok {
+		b.forward.Delete(k)
+		b.size.Add(-1)
+	}
+}
+
+// Size returns the number of elements in the ConcurrentBiMap.
+func (b *ConcurrentBiMap[K, V]) Size() int {
+	return int(b.size.Load())
+}
+
+// All returns an iterator over the ConcurrentBiMap's key-value pairs, in no particular order. Like sync.Map.Range,
+// it holds no lock and does not reflect a consistent snapshot if the map is modified during iteration.
+func (b *ConcurrentBiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		b.forward.Range(func(k, v any) bool {
+			return yield(k.(K), v.(V))
+		})
+	}
+}
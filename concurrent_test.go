@@ -0,0 +1,62 @@
+package bimap
+
+import (
+	"maps"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentBiMap(t *testing.T) {
+	actual := NewConcurrentBiMap[string, int]()
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+
+	assert.Equal(t, 2, actual.Size())
+	v, ok := actual.GetByKey("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	k, ok := actual.GetByValue(2)
+	assert.True(t, ok)
+	assert.Equal(t, "b", k)
+
+	actual.Insert("a", 1)
+	assert.Equal(t, 2, actual.Size(), "Reinserting the same pair should be a no-op")
+	actual.Insert("a", 10)
+	assert.False(t, actual.ExistsByValue(1), "Replaced value should be gone")
+	actual.Insert("c", 2)
+	assert.False(t, actual.ExistsByKey("b"), "Previous owner of the value should be removed")
+	assert.Equal(t, 2, actual.Size())
+	assert.Equal(t, map[string]int{"a": 10, "c": 2}, maps.Collect(actual.All()))
+
+	actual.DeleteByKey("a")
+	actual.DeleteByValue(2)
+	actual.DeleteByKey("missing")
+	assert.Equal(t, 0, actual.Size())
+	assert.False(t, actual.ExistsByKey("c"))
+}
+
+func TestConcurrentBiMap_ConcurrentReaders(t *testing.T) {
+	actual := NewConcurrentBiMap[int, int]()
+	for i := range 100 {
+		actual.Insert(i, -i)
+	}
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				if v, ok := actual.GetByKey(i); ok {
+					assert.Equal(t, -i, v)
+				}
+			}
+		}()
+	}
+	for i := range 100 {
+		actual.Insert(i, -i)
+	}
+	wg.Wait()
+	assert.Equal(t, 100, actual.Size())
+}
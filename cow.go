@@ -0,0 +1,119 @@
+package bimap
+
+import (
+	"iter"
+	"maps"
+	"sync"
+	"sync/atomic"
+)
+
+// COWBiMap is a copy-on-write bidirectional map: every write publishes a new ImmutableBiMap snapshot through an
+// atomic pointer, so reads never take a lock. Each write copies the whole map, so it suits tables that are read far
+// more often than they are written. Safe for concurrent use.
+type COWBiMap[K comparable, V comparable] struct {
+	writer sync.Mutex
+	snap   atomic.Pointer[ImmutableBiMap[K, V]]
+}
+
+// NewCOWBiMap returns an empty COWBiMap.
+func NewCOWBiMap[K comparable, V comparable]() *COWBiMap[K, V] {
+	b := &COWBiMap[K, V]{}
+	b.snap.Store(&ImmutableBiMap[K, V]{forward: make(map[K]V), inverse: make(map[V]K)})
+	return b
+}
+
+// Insert publishes a snapshot mapping k to v. Any existing pairing of k or of v is removed so the map stays a bijection.
+func (b *COWBiMap[K, V]) Insert(k K, v V) {
+	b.writer.Lock()
+	defer b.writer.Unlock()
+	current := b.snap.Load()
+	if old, ok := current.forward[k]; ok && old == v {
+		return
+	}
+	forward, inverse := maps.Clone(current.forward), maps.Clone(current.inverse)
+	if old, ok := forward[k]; ok {
+		delete(inverse, old)
+	}
+	if owner, ok := inverse[v]; ok {
+		delete(forward, owner)
+	}
+	forward[k] = v
+	inverse[v] = k
+	b.snap.Store(&ImmutableBiMap[K, V]{forward: forward, inverse: inverse})
+}
+
+// DeleteByKey publishes a snapshot without k. Returns without copying if the key doesn't exist.
+func (b *COWBiMap[K, V]) DeleteByKey(k K) {
+	b.writer.Lock()
+	defer b.writer.Unlock()
+	current := b.snap.Load()
+	if v, ok := current.forward[k]; ok {
+		b.deletePair(current, k, v)
+	}
+}
+
+// DeleteByValue publishes a snapshot without v. Returns without copying if the value doesn't exist.
+func (b *COWBiMap[K, V]) DeleteByValue(v V) {
+	b.writer.Lock()
+	defer b.writer.Unlock()
+	current := b.snap.Load()
+	if k, ok := current.inverse[v]; ok {
+		b.deletePair(current, k, v)
+	}
+}
+
+// deletePair publishes a copy of current without the pair k, v. The caller must hold the writer lock.
+func (b *COWBiMap[K, V]) deletePair(current *ImmutableBiMap[K, V], k K, v V) {
+	forward, inverse := maps.Clone(current.forward), maps.Clone(current.inverse)
+	delete(forward, k)
+	delete(inverse, v)
+	b.snap.Store(&ImmutableBiMap[K, V]{forward: forward, inverse: inverse})
+}
+
+// Store replaces the whole map with snap in one write, which is cheaper than inserting a new table pair by pair.
+// A nil snap, or a zero value such as one decoded from JSON null, empties the map.
+func (b *COWBiMap[K, V]) Store(snap *ImmutableBiMap[K, V]) {
+	if snap == nil || snap.forward == nil || snap.inverse == nil {
+		snap = &ImmutableBiMap[K, V]{forward: make(map[K]V), inverse: make(map[V]K)}
+	}
+	b.writer.Lock()
+	defer b.writer.Unlock()
+	b.snap.Store(snap)
+}
+
+// Snapshot returns the current contents. It is never modified, so it can be read at leisure while writes continue.
+func (b *COWBiMap[K, V]) Snapshot() *ImmutableBiMap[K, V] {
+	return b.snap.Load()
+}
+
+// GetByKey returns the value for a given key and whether or not the element was present.
+func (b *COWBiMap[K, V]) GetByKey(k K) (V, bool) {
+	return b.snap.Load().GetByKey(k)
+}
+
+// GetByValue returns the key for a given value and whether or not the element was present.
+func (b *COWBiMap[K, V]) GetByValue(v V) (K, bool) {
+	return b.snap.Load().GetByValue(v)
+}
+
+// ExistsByKey checks whether or not a key exists in the COWBiMap.
+func (b *COWBiMap[K, V]) ExistsByKey(k K) bool {
+	return b.snap.Load().ExistsByKey(k)
+}
+
+// ExistsByValue checks whether or not a value exists in the COWBiMap.
+func (b *COWBiMap[K, V]) ExistsByValue(v V) bool {
+	return b.snap.Load().ExistsByValue(v)
+}
+
+// Size returns the number of elements in the COWBiMap.
+func (b *COWBiMap[K, V]) Size() int {
+	return b.snap.Load().Size()
+}
+
+// All returns an iterator over the pairs of the snapshot current when iteration starts, in no particular order.
+func (b *COWBiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		b.snap.Load().All()(yield)
+	}
+}
@@ -0,0 +1,57 @@
+package bimap
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCOWBiMap(t *testing.T) {
+	actual := NewCOWBiMap[string, int]()
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+
+	assert.Equal(t, 2, actual.Size())
+	v, ok := actual.GetByKey("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	k, ok := actual.GetByValue(2)
+	assert.True(t, ok)
+	assert.Equal(t, "b", k)
+
+	before := actual.Snapshot()
+	actual.Insert("a", 10)
+	assert.False(t, actual.ExistsByValue(1), "Replaced value should be gone")
+	actual.Insert("c", 2)
+	assert.False(t, actual.ExistsByKey("b"), "Previous owner of the value should be removed")
+	assert.Equal(t, map[string]int{"a": 10, "c": 2}, maps.Collect(actual.All()))
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, maps.Collect(before.All()), "Snapshots should not change")
+
+	actual.DeleteByKey("a")
+	actual.DeleteByValue(2)
+	actual.DeleteByKey("missing")
+	assert.Equal(t, 0, actual.Size())
+}
+
+func TestCOWBiMap_Store(t *testing.T) {
+	actual := NewCOWBiMap[string, int]()
+	actual.Insert("stale", 0)
+
+	table := NewImmutableBiMapFromMap(map[string]int{"a": 1, "b": 2})
+	actual.Store(table)
+	assert.Same(t, table, actual.Snapshot())
+	assert.False(t, actual.ExistsByKey("stale"))
+}
+
+func TestCOWBiMap_StoreEmpty(t *testing.T) {
+	actual := NewCOWBiMap[int, int]()
+
+	actual.Store(&ImmutableBiMap[int, int]{})
+	assert.NotPanics(t, func() { actual.Insert(1, 1) }, "A zero value snapshot should leave the map writable")
+	assert.Equal(t, 1, actual.Size())
+
+	actual.Store(nil)
+	assert.Equal(t, 0, actual.Size())
+	assert.NotPanics(t, func() { actual.Insert(2, 2) }, "A nil snapshot should empty the map")
+}
@@ -0,0 +1,110 @@
+package bimap
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// CSVOption configures WriteCSV and ReadBiMapFromCSV.
+type CSVOption func(*csvConfig)
+
+type csvConfig struct {
+	header    []string
+	delimiter rune
+}
+
+// WithCSVHeader makes WriteCSV emit a header row naming the key and value columns, and ReadBiMapFromCSV skip the first row.
+func WithCSVHeader(keyColumn, valueColumn string) CSVOption {
+	return func(c *csvConfig) {
+		c.header = []string{keyColumn, valueColumn}
+	}
+}
+
+// WithCSVDelimiter sets the field delimiter, which defaults to a comma.
+func WithCSVDelimiter(r rune) CSVOption {
+	return func(c *csvConfig) {
+		c.delimiter = r
+	}
+}
+
+func newCSVConfig(opts []CSVOption) csvConfig {
+	c := csvConfig{delimiter: ','}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// WriteCSV writes the BiMap as two-column CSV rows of key and value, sorted by key so dumps can be diffed.
+// Keys and values are formatted with MarshalText if they implement encoding.TextMarshaler, or fmt.Sprint otherwise.
+func (b *BiMap[K, V]) WriteCSV(w io.Writer, opts ...CSVOption) error {
+	c := newCSVConfig(opts)
+	cw := csv.NewWriter(w)
+	cw.Comma = c.delimiter
+	if c.header != nil {
+		if err := cw.Write(c.header); err != nil {
+			return err
+		}
+	}
+	for _, p := range b.Entries() {
+		k, err := formatCell(p.Key)
+		if err != nil {
+			return fmt.Errorf("bimap: writing CSV: key %v: %w", p.Key, err)
+		}
+		v, err := formatCell(p.Value)
+		if err != nil {
+			return fmt.Errorf("bimap: writing CSV: value %v: %w", p.Value, err)
+		}
+		if err := cw.Write([]string{k, v}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadBiMapFromCSV builds a BiMap from two-column CSV rows, converting the cells with parseK and parseV. A nil parse
+// function decodes cells with UnmarshalText for types implementing encoding.TextUnmarshaler, takes them verbatim for
+// strings and decodes them as JSON otherwise. It returns ErrDuplicateKey or ErrDuplicateValue if the rows do not
+// describe a bijection. Errors report the offending line.
+func ReadBiMapFromCSV[K comparable, V comparable](r io.Reader, parseK func(string) (K, error), parseV func(string) (V, error), opts ...CSVOption) (*BiMap[K, V], error) {
+	if parseK == nil {
+		parseK = parseCell[K]
+	}
+	if parseV == nil {
+		parseV = parseCell[V]
+	}
+	c := newCSVConfig(opts)
+	cr := csv.NewReader(r)
+	cr.Comma = c.delimiter
+	cr.FieldsPerRecord = 2
+	if c.header != nil {
+		if _, err := cr.Read(); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("bimap: reading CSV header: %w", err)
+		}
+	}
+	b := NewBiMap[K, V]()
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return b, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("bimap: reading CSV: %w", err)
+		}
+		line, _ := cr.FieldPos(0)
+		k, err := parseK(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("bimap: reading CSV: line %d: key: %w", line, err)
+		}
+		v, err := parseV(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("bimap: reading CSV: line %d: value: %w", line, err)
+		}
+		if err := b.InsertStrict(k, v); err != nil {
+			return nil, fmt.Errorf("bimap: reading CSV: line %d: %w", line, err)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package bimap
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func parseString(s string) (string, error) { return s, nil }
+
+func TestBiMap_WriteCSV(t *testing.T) {
+	b := NewBiMapFromMap(map[string]int{"b": 2, "a": 1, "c,d": 3})
+
+	var buf bytes.Buffer
+	assert.NoError(t, b.WriteCSV(&buf))
+	assert.Equal(t, "a,1\nb,2\n\"c,d\",3\n", buf.String(), "Rows should be sorted by key and quoted as needed")
+
+	buf.Reset()
+	assert.NoError(t, b.WriteCSV(&buf, WithCSVHeader("name", "id"), WithCSVDelimiter(';')))
+	assert.Equal(t, "name;id\na;1\nb;2\nc,d;3\n", buf.String())
+}
+
+func TestReadBiMapFromCSV(t *testing.T) {
+	b := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+	var buf bytes.Buffer
+	assert.NoError(t, b.WriteCSV(&buf, WithCSVHeader("name", "id"), WithCSVDelimiter('\t')))
+
+	actual, err := ReadBiMapFromCSV(&buf, parseString, strconv.Atoi, WithCSVHeader("name", "id"), WithCSVDelimiter('\t'))
+	assert.NoError(t, err)
+	assert.True(t, b.Equal(actual), "Round trip should preserve the pairs")
+
+	empty, err := ReadBiMapFromCSV(strings.NewReader(""), parseString, strconv.Atoi, WithCSVHeader("name", "id"))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, empty.Size())
+}
+
+func TestReadBiMapFromCSV_Errors(t *testing.T) {
+	_, err := ReadBiMapFromCSV(strings.NewReader("a,1\nb,x\n"), parseString, strconv.Atoi)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+	assert.ErrorContains(t, err, "line 2")
+
+	_, err = ReadBiMapFromCSV(strings.NewReader("a,1\nb,1\n"), parseString, strconv.Atoi)
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+
+	_, err = ReadBiMapFromCSV(strings.NewReader("a,1,extra\n"), parseString, strconv.Atoi)
+	assert.Error(t, err, "Rows must have exactly two columns")
+}
@@ -0,0 +1,50 @@
+package bimap
+
+import "sort"
+
+// BiMapDiff describes how one bimap differs from another. Each slice is sorted by key.
+type BiMapDiff[K comparable, V comparable] struct {
+	// Added holds the pairs whose keys are only in the second bimap.
+	Added []Pair[K, V]
+	// Removed holds the pairs whose keys are only in the first bimap.
+	Removed []Pair[K, V]
+	// Changed holds the keys present in both bimaps with different values.
+	Changed []Change[K, V]
+}
+
+// Change records a key whose value differs between two bimaps.
+type Change[K comparable, V comparable] struct {
+	Key      K
+	OldValue V
+	NewValue V
+}
+
+// Empty reports whether the diff records no differences.
+func (d BiMapDiff[K, V]) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Diff returns the changes that turn a into b, e.g. to invalidate caches after reloading a table. a and b are never
+// locked at the same time.
+func Diff[K comparable, V comparable](a, b *BiMap[K, V]) BiMapDiff[K, V] {
+	before, after := a.SnapshotForward(), b.SnapshotForward()
+	var d BiMapDiff[K, V]
+	for k, v := range before {
+		nv, ok := after[k]
+		switch {
+		case !ok:
+			d.Removed = append(d.Removed, Pair[K, V]{Key: k, Value: v})
+		case nv != v:
+			d.Changed = append(d.Changed, Change[K, V]{Key: k, OldValue: v, NewValue: nv})
+		}
+	}
+	for k, v := range after {
+		if _, ok := before[k]; !ok {
+			d.Added = append(d.Added, Pair[K, V]{Key: k, Value: v})
+		}
+	}
+	sortPairs(d.Added)
+	sortPairs(d.Removed)
+	sort.Slice(d.Changed, func(i, j int) bool { return compareAny(d.Changed[i].Key, d.Changed[j].Key) < 0 })
+	return d
+}
@@ -0,0 +1,24 @@
+package bimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	a := NewBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3, "d": 4})
+	b := NewBiMapFromMap(map[string]int{"a": 1, "b": 20, "d": 40, "e": 5, "f": 6})
+
+	actual := Diff(a, b)
+	assert.False(t, actual.Empty())
+	assert.Equal(t, []Pair[string, int]{{"e", 5}, {"f", 6}}, actual.Added)
+	assert.Equal(t, []Pair[string, int]{{"c", 3}}, actual.Removed)
+	assert.Equal(t, []Change[string, int]{{"b", 2, 20}, {"d", 4, 40}}, actual.Changed)
+}
+
+func TestDiff_Equal(t *testing.T) {
+	a := NewBiMapFromMap(map[string]int{"a": 1})
+
+	assert.True(t, Diff(a, a.Clone()).Empty())
+}
@@ -0,0 +1,245 @@
+package bimap
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"iter"
+	"math"
+	"slices"
+	"sort"
+)
+
+// The disk table format, all integers little-endian:
+//
+//	header   magic [8]byte, version uint32, reserved uint32, count uint64, blob length uint64
+//	entries  count × {offset uint64, key length uint32, value length uint32}, sorted by key
+//	values   count × uint32 entry numbers, sorted by value
+//	blob     each entry's key followed by its value
+const (
+	diskMagic       = "BIMAPDSK"
+	diskVersion     = 1
+	diskHeaderSize  = 32
+	diskEntrySize   = 16
+	diskValueSize   = 4
+	diskMaxEntryLen = math.MaxUint32
+)
+
+// DiskBiMap is a read-only bidirectional map of strings stored in a file written by CompileDiskBiMap. The file is
+// memory-mapped where the platform supports it, so tables with tens of millions of entries are paged in by the
+// operating system instead of living on the Go heap. Lookups in either direction are binary searches over sorted
+// indexes. A DiskBiMap is safe for concurrent use until it is closed.
+type DiskBiMap struct {
+	data    []byte
+	entries []byte
+	values  []byte
+	blob    []byte
+	count   int
+	unmap   func() error
+}
+
+// CompileDiskBiMap writes pairs to path in the format read by OpenDiskBiMap. It returns ErrDuplicateKey or
+// ErrDuplicateValue, without creating the file, if pairs do not describe a bijection. Like SaveToFile, the file is
+// written through a temporary file and a rename. The pairs are held in memory while they are sorted.
+func CompileDiskBiMap(path string, pairs iter.Seq2[string, string]) error {
+	var entries []Pair[string, string]
+	for k, v := range pairs {
+		if uint64(len(k)) > diskMaxEntryLen || uint64(len(v)) > diskMaxEntryLen {
+			return fmt.Errorf("bimap: compiling %s: pair for %.32q is too long", path, k)
+		}
+		entries = append(entries, Pair[string, string]{Key: k, Value: v})
+	}
+	if uint64(len(entries)) > math.MaxUint32 {
+		return fmt.Errorf("bimap: compiling %s: %d pairs exceed the limit of %d", path, len(entries), uint32(math.MaxUint32))
+	}
+	slices.SortFunc(entries, func(a, b Pair[string, string]) int { return cmp.Compare(a.Key, b.Key) })
+	for i := 1; i < len(entries); i++ {
+		if entries[i].Key == entries[i-1].Key {
+			return fmt.Errorf("bimap: compiling %s: %w: %q", path, ErrDuplicateKey, entries[i].Key)
+		}
+	}
+	byValue := make([]uint32, len(entries))
+	for i := range byValue {
+		byValue[i] = uint32(i)
+	}
+	slices.SortFunc(byValue, func(a, b uint32) int { return cmp.Compare(entries[a].Value, entries[b].Value) })
+	for i := 1; i < len(byValue); i++ {
+		if v := entries[byValue[i]].Value; v == entries[byValue[i-1]].Value {
+			return fmt.Errorf("bimap: compiling %s: %w: %q", path, ErrDuplicateValue, v)
+		}
+	}
+
+	err := writeFileAtomic(path, func(w io.Writer) error {
+		bw := bufio.NewWriter(w)
+		var blobLen uint64
+		for _, p := range entries {
+			blobLen += uint64(len(p.Key) + len(p.Value))
+		}
+		header := make([]byte, diskHeaderSize)
+		copy(header, diskMagic)
+		binary.LittleEndian.PutUint32(header[8:], diskVersion)
+		binary.LittleEndian.PutUint64(header[16:], uint64(len(entries)))
+		binary.LittleEndian.PutUint64(header[24:], blobLen)
+		bw.Write(header)
+		var buf [diskEntrySize]byte
+		var offset uint64
+		for _, p := range entries {
+			binary.LittleEndian.PutUint64(buf[0:], offset)
+			binary.LittleEndian.PutUint32(buf[8:], uint32(len(p.Key)))
+			binary.LittleEndian.PutUint32(buf[12:], uint32(len(p.Value)))
+			bw.Write(buf[:])
+			offset += uint64(len(p.Key) + len(p.Value))
+		}
+		for _, i := range byValue {
+			bw.Write(binary.LittleEndian.AppendUint32(buf[:0], i))
+		}
+		for _, p := range entries {
+			bw.WriteString(p.Key)
+			bw.WriteString(p.Value)
+		}
+		return bw.Flush()
+	})
+	if err != nil {
+		return fmt.Errorf("bimap: compiling %s: %w", path, err)
+	}
+	return nil
+}
+
+// OpenDiskBiMap opens a file written by CompileDiskBiMap. It checks the file's structure, reading its entry table
+// once, so a truncated or corrupted file is reported here rather than by a later lookup. Close the DiskBiMap to
+// release the mapping.
+func OpenDiskBiMap(path string) (*DiskBiMap, error) {
+	data, unmap, err := mapFile(path)
+	if err != nil {
+		return nil, err
+	}
+	d, err := parseDiskBiMap(data)
+	if err != nil {
+		if unmap != nil {
+			unmap()
+		}
+		return nil, fmt.Errorf("bimap: opening %s: %w", path, err)
+	}
+	d.unmap = unmap
+	return d, nil
+}
+
+func parseDiskBiMap(data []byte) (*DiskBiMap, error) {
+	if len(data) < diskHeaderSize || string(data[:8]) != diskMagic {
+		return nil, fmt.Errorf("not a disk table")
+	}
+	if v := binary.LittleEndian.Uint32(data[8:]); v != diskVersion {
+		return nil, fmt.Errorf("unsupported disk table version %d", v)
+	}
+	count := binary.LittleEndian.Uint64(data[16:])
+	blobLen := binary.LittleEndian.Uint64(data[24:])
+	rest := uint64(len(data) - diskHeaderSize)
+	if count > rest/(diskEntrySize+diskValueSize) || rest-count*(diskEntrySize+diskValueSize) != blobLen {
+		return nil, fmt.Errorf("disk table has the wrong size for %d entries", count)
+	}
+	valuesAt := diskHeaderSize + count*diskEntrySize
+	blobAt := valuesAt + count*diskValueSize
+	d := &DiskBiMap{
+		data:    data,
+		entries: data[diskHeaderSize:valuesAt],
+		values:  data[valuesAt:blobAt],
+		blob:    data[blobAt:],
+		count:   int(count),
+	}
+	for i := range d.count {
+		e := d.entries[i*diskEntrySize:]
+		offset := binary.LittleEndian.Uint64(e)
+		n := uint64(binary.LittleEndian.Uint32(e[8:])) + uint64(binary.LittleEndian.Uint32(e[12:]))
+		if offset > blobLen || n > blobLen-offset {
+			return nil, fmt.Errorf("disk table entry %d is out of bounds", i)
+		}
+		if binary.LittleEndian.Uint32(d.values[i*diskValueSize:]) >= uint32(count) {
+			return nil, fmt.Errorf("disk table value index %d is out of bounds", i)
+		}
+	}
+	return d, nil
+}
+
+// pair returns the key and value bytes of entry i.
+func (d *DiskBiMap) pair(i int) (key, value []byte) {
+	e := d.entries[i*diskEntrySize:]
+	offset := binary.LittleEndian.Uint64(e)
+	kl := uint64(binary.LittleEndian.Uint32(e[8:]))
+	vl := uint64(binary.LittleEndian.Uint32(e[12:]))
+	return d.blob[offset : offset+kl], d.blob[offset+kl : offset+kl+vl]
+}
+
+// byValue returns the entry number holding the i-th smallest value.
+func (d *DiskBiMap) byValue(i int) int {
+	return int(binary.LittleEndian.Uint32(d.values[i*diskValueSize:]))
+}
+
+// GetByKey returns the value for a given key and whether or not the element was present.
+func (d *DiskBiMap) GetByKey(k string) (string, bool) {
+	i := sort.Search(d.count, func(i int) bool {
+		key, _ := d.pair(i)
+		return string(key) >= k
+	})
+	if i < d.count {
+		if key, value := d.pair(i); string(key) == k {
+			return string(value), true
+		}
+	}
+	return "", false
+}
+
+// GetByValue returns the key for a given value and whether or not the element was present.
+func (d *DiskBiMap) GetByValue(v string) (string, bool) {
+	i := sort.Search(d.count, func(i int) bool {
+		_, value := d.pair(d.byValue(i))
+		return string(value) >= v
+	})
+	if i < d.count {
+		if key, value := d.pair(d.byValue(i)); string(value) == v {
+			return string(key), true
+		}
+	}
+	return "", false
+}
+
+// ExistsByKey checks whether or not a key exists in the DiskBiMap.
+func (d *DiskBiMap) ExistsByKey(k string) bool {
+	_, ok := d.GetByKey(k)
+	return ok
+}
+
+// ExistsByValue checks whether or not a value exists in the DiskBiMap.
+func (d *DiskBiMap) ExistsByValue(v string) bool {
+	_, ok := d.GetByValue(v)
+	return ok
+}
+
+// Size returns the number of elements in the DiskBiMap.
+func (d *DiskBiMap) Size() int {
+	return d.count
+}
+
+// All returns an iterator over the DiskBiMap's key-value pairs in key order.
+func (d *DiskBiMap) All() iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		for i := range d.count {
+			key, value := d.pair(i)
+			if !yield(string(key), string(value)) {
+				return
+			}
+		}
+	}
+}
+
+// Close releases the file mapping. The DiskBiMap must not be used afterwards.
+func (d *DiskBiMap) Close() error {
+	d.data, d.entries, d.values, d.blob, d.count = nil, nil, nil, nil, 0
+	if d.unmap == nil {
+		return nil
+	}
+	unmap := d.unmap
+	d.unmap = nil
+	return unmap()
+}
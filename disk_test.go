@@ -0,0 +1,102 @@
+package bimap
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiskBiMap(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "codes.bimap")
+	src := map[string]string{"": "empty", "a": "1", "b": "2", "café": "3"}
+	for i := range 1000 {
+		src["k"+strconv.Itoa(i)] = "v" + strconv.Itoa(i)
+	}
+	assert.NoError(t, CompileDiskBiMap(path, maps.All(src)))
+
+	d, err := OpenDiskBiMap(path)
+	assert.NoError(t, err)
+	defer d.Close()
+
+	assert.Equal(t, len(src), d.Size())
+	for k, v := range src {
+		got, ok := d.GetByKey(k)
+		assert.True(t, ok, k)
+		assert.Equal(t, v, got)
+		got, ok = d.GetByValue(v)
+		assert.True(t, ok, v)
+		assert.Equal(t, k, got)
+	}
+	assert.False(t, d.ExistsByKey("missing"))
+	assert.False(t, d.ExistsByValue("zzz"))
+	assert.True(t, d.ExistsByKey(""))
+
+	assert.Equal(t, src, maps.Collect(d.All()))
+	var keys []string
+	for k := range d.All() {
+		keys = append(keys, k)
+	}
+	assert.True(t, slices.IsSorted(keys), "All should iterate in key order")
+}
+
+func TestDiskBiMap_Empty(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.bimap")
+	assert.NoError(t, CompileDiskBiMap(path, maps.All(map[string]string{})))
+
+	d, err := OpenDiskBiMap(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, d.Size())
+	assert.False(t, d.ExistsByKey(""))
+	assert.NoError(t, d.Close())
+}
+
+func TestCompileDiskBiMap_Duplicates(t *testing.T) {
+	dir := t.TempDir()
+	pairs := func(yield func(string, string) bool) {
+		_ = yield("a", "1") && yield("b", "1")
+	}
+	assert.ErrorIs(t, CompileDiskBiMap(filepath.Join(dir, "dup.bimap"), pairs), ErrDuplicateValue)
+
+	pairs = func(yield func(string, string) bool) {
+		_ = yield("a", "1") && yield("a", "2")
+	}
+	assert.ErrorIs(t, CompileDiskBiMap(filepath.Join(dir, "dup.bimap"), pairs), ErrDuplicateKey)
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, entries, "No file should be written for invalid pairs")
+}
+
+func TestOpenDiskBiMap_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "codes.bimap")
+	assert.NoError(t, CompileDiskBiMap(path, maps.All(map[string]string{"a": "1", "b": "2"})))
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+
+	corrupt := func(name string, data []byte) string {
+		p := filepath.Join(dir, name)
+		assert.NoError(t, os.WriteFile(p, data, 0o644))
+		return p
+	}
+	badOffset := slices.Clone(data)
+	badOffset[diskHeaderSize] = 0xff
+
+	for _, p := range []string{
+		corrupt("empty", nil),
+		corrupt("magic", append([]byte("NOTATABL"), data[8:]...)),
+		corrupt("truncated", data[:len(data)-1]),
+		corrupt("offset", badOffset),
+	} {
+		_, err := OpenDiskBiMap(p)
+		assert.Error(t, err, p)
+	}
+
+	_, err = OpenDiskBiMap(filepath.Join(dir, "missing"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,88 @@
+package bimap
+
+import (
+	"fmt"
+	"sort"
+)
+
+// RegisterEnum builds an ImmutableBiMap from an iota-style enum and its names.
+// It returns an error if the constants are not contiguous or if two constants share a name,
+// which catches drift between a const block and its string table at startup.
+func RegisterEnum[T ~int](pairs map[T]string) (*ImmutableBiMap[T, string], error) {
+	keys := make([]T, 0, len(pairs))
+	names := make(map[string]T, len(pairs))
+	for k, name := range pairs {
+		if other, ok := names[name]; ok {
+			if other > k {
+				other, k = k, other
+			}
+			return nil, fmt.Errorf("%w: %q used by %d and %d", ErrDuplicateName, name, other, k)
+		}
+		names[name] = k
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	for i := 1; i < len(keys); i++ {
+		if keys[i] != keys[i-1]+1 {
+			return nil, fmt.Errorf("%w: missing %d", ErrEnumGap, keys[i-1]+1)
+		}
+	}
+	return NewImmutableBiMapFromMap(pairs), nil
+}
+
+// RegisterEnumRange behaves like RegisterEnum but additionally checks that the registered
+// constants cover exactly first through last, inclusive.
+func RegisterEnumRange[T ~int](pairs map[T]string, first, last T) (*ImmutableBiMap[T, string], error) {
+	for k := range pairs {
+		if k < first || k > last {
+			return nil, fmt.Errorf("%w: %d not in [%d, %d]", ErrEnumOutOfRange, k, first, last)
+		}
+	}
+	for _, k := range []T{first, last} {
+		if _, ok := pairs[k]; !ok {
+			return nil, fmt.Errorf("%w: missing %d", ErrEnumGap, k)
+		}
+	}
+	return RegisterEnum(pairs)
+}
+
+// NewEnumBiMap builds an ImmutableBiMap pairing values[i] with names[i], for enums that are not iota-style or are
+// string-based. It returns ErrLengthMismatch if the slices differ in length, ErrDuplicateKey if a value is listed
+// twice and ErrDuplicateName if two values share a name.
+func NewEnumBiMap[T ~int | ~string](values []T, names []string) (*ImmutableBiMap[T, string], error) {
+	if len(values) != len(names) {
+		return nil, fmt.Errorf("%w: %d values, %d names", ErrLengthMismatch, len(values), len(names))
+	}
+	forward := make(map[T]string, len(values))
+	inverse := make(map[string]T, len(values))
+	for i, v := range values {
+		if _, ok := forward[v]; ok {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateKey, v)
+		}
+		if other, ok := inverse[names[i]]; ok {
+			return nil, fmt.Errorf("%w: %q used by %v and %v", ErrDuplicateName, names[i], other, v)
+		}
+		forward[v] = names[i]
+		inverse[names[i]] = v
+	}
+	return &ImmutableBiMap[T, string]{forward: forward, inverse: inverse}, nil
+}
+
+// MustNameOf returns the name of v in enum, panicking with a message naming v and its type if v is not registered.
+func MustNameOf[T comparable](enum *ImmutableBiMap[T, string], v T) string {
+	name, ok := enum.GetByKey(v)
+	if !ok {
+		panic(fmt.Sprintf("bimap: %T value %v has no registered name", v, v))
+	}
+	return name
+}
+
+// MustValueOf returns the value named name in enum, panicking with a message naming name and the enum type if name
+// is not registered.
+func MustValueOf[T comparable](enum *ImmutableBiMap[T, string], name string) T {
+	v, ok := enum.GetByValue(name)
+	if !ok {
+		panic(fmt.Sprintf("bimap: no %T value is named %q", v, name))
+	}
+	return v
+}
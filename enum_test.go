@@ -0,0 +1,78 @@
+package bimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type color int
+
+const (
+	red color = iota
+	green
+	blue
+)
+
+func TestRegisterEnum(t *testing.T) {
+	m, err := RegisterEnum(map[color]string{red: "red", green: "green", blue: "blue"})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, m.Size())
+
+	name, _ := m.GetByKey(green)
+	assert.Equal(t, "green", name)
+	c, _ := m.GetByValue("blue")
+	assert.Equal(t, blue, c)
+}
+
+func TestRegisterEnum_Gap(t *testing.T) {
+	_, err := RegisterEnum(map[color]string{red: "red", blue: "blue"})
+	assert.ErrorIs(t, err, ErrEnumGap)
+}
+
+func TestRegisterEnum_DuplicateName(t *testing.T) {
+	_, err := RegisterEnum(map[color]string{red: "red", green: "red"})
+	assert.ErrorIs(t, err, ErrDuplicateName)
+}
+
+func TestRegisterEnumRange(t *testing.T) {
+	_, err := RegisterEnumRange(map[color]string{red: "red", green: "green", blue: "blue"}, red, blue)
+	assert.NoError(t, err)
+
+	_, err = RegisterEnumRange(map[color]string{red: "red", green: "green"}, red, blue)
+	assert.ErrorIs(t, err, ErrEnumGap, "Missing last constant should be reported")
+
+	_, err = RegisterEnumRange(map[color]string{red: "red", green: "green", blue: "blue", 3: "extra"}, red, blue)
+	assert.ErrorIs(t, err, ErrEnumOutOfRange)
+}
+
+type suit string
+
+func TestNewEnumBiMap(t *testing.T) {
+	colors, err := NewEnumBiMap([]color{red, green, blue}, []string{"red", "green", "blue"})
+	assert.NoError(t, err)
+	assert.Equal(t, "green", MustNameOf(colors, green))
+	assert.Equal(t, blue, MustValueOf(colors, "blue"))
+
+	suits, err := NewEnumBiMap([]suit{"H", "S"}, []string{"hearts", "spades"})
+	assert.NoError(t, err)
+	assert.Equal(t, suit("S"), MustValueOf(suits, "spades"))
+}
+
+func TestNewEnumBiMap_Invalid(t *testing.T) {
+	_, err := NewEnumBiMap([]color{red, green}, []string{"red"})
+	assert.ErrorIs(t, err, ErrLengthMismatch)
+
+	_, err = NewEnumBiMap([]color{red, red}, []string{"red", "crimson"})
+	assert.ErrorIs(t, err, ErrDuplicateKey)
+
+	_, err = NewEnumBiMap([]color{red, green}, []string{"red", "red"})
+	assert.ErrorIs(t, err, ErrDuplicateName)
+}
+
+func TestMustNameOf_Panics(t *testing.T) {
+	colors, _ := NewEnumBiMap([]color{red}, []string{"red"})
+
+	assert.PanicsWithValue(t, "bimap: bimap.color value 2 has no registered name", func() { MustNameOf(colors, blue) })
+	assert.PanicsWithValue(t, `bimap: no bimap.color value is named "teal"`, func() { MustValueOf(colors, "teal") })
+}
@@ -0,0 +1,42 @@
+package bimap
+
+import "errors"
+
+// ErrEnumGap is returned when an enum registration is missing a value between its lowest and highest constant.
+var ErrEnumGap = errors.New("bimap: enum has a gap")
+
+// ErrDuplicateName is returned when two enum constants are registered under the same name.
+var ErrDuplicateName = errors.New("bimap: duplicate enum name")
+
+// ErrEnumOutOfRange is returned when an enum registration does not match the expected range of constants.
+var ErrEnumOutOfRange = errors.New("bimap: enum value out of range")
+
+// ErrDuplicateValue is returned when an operation would map the same value from two different keys.
+var ErrDuplicateValue = errors.New("bimap: duplicate value")
+
+// ErrDuplicateKey is returned when an operation would map the same key to two different values.
+var ErrDuplicateKey = errors.New("bimap: duplicate key")
+
+// ErrNotFound is returned when an operation targets a key or value that is not present.
+var ErrNotFound = errors.New("bimap: not found")
+
+// ErrQuotaExceeded is returned when inserting a new key into a BiMap that has reached its WithMaxEntries limit.
+var ErrQuotaExceeded = errors.New("bimap: quota exceeded")
+
+// ErrInvalidKey is returned when a key is rejected by a WithKeyValidator function.
+var ErrInvalidKey = errors.New("bimap: invalid key")
+
+// ErrInvalidValue is returned when a value is rejected by a WithValueValidator function.
+var ErrInvalidValue = errors.New("bimap: invalid value")
+
+// ErrCorrupted is returned when a BiMap's forward and inverse indexes are found to be out of sync.
+var ErrCorrupted = errors.New("bimap: forward and inverse indexes are inconsistent")
+
+// ErrImmutable is returned by the Try methods when modifying a BiMap that has been made immutable.
+var ErrImmutable = errors.New("bimap: map is immutable")
+
+// ErrClosed is returned by WaitForKey when the BiMap is returned to a Pool while the caller is waiting.
+var ErrClosed = errors.New("bimap: map was recycled")
+
+// ErrLengthMismatch is returned when parallel slices of keys and values differ in length.
+var ErrLengthMismatch = errors.New("bimap: keys and values differ in length")
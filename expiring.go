@@ -0,0 +1,191 @@
+package bimap
+
+import (
+	"sync"
+	"time"
+)
+
+// ExpiringBiMap is a thread safe bidirectional map whose entries expire after a time-to-live. Expired entries are
+// invisible to lookups immediately and are removed from both directions by Purge, which a background janitor can run
+// periodically. Like SortedBiMap, Insert removes any existing pairing of the value so the map stays a bijection.
+type ExpiringBiMap[K comparable, V comparable] struct {
+	s       sync.RWMutex
+	ttl     time.Duration
+	forward map[K]V
+	inverse map[V]K
+	expires map[K]time.Time
+	now     func() time.Time
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewExpiringBiMap returns an empty ExpiringBiMap whose entries expire ttl after they are inserted, or never if ttl
+// is not positive. If cleanupInterval is positive, a janitor goroutine purges expired entries at that interval until
+// Close is called.
+func NewExpiringBiMap[K comparable, V comparable](ttl, cleanupInterval time.Duration) *ExpiringBiMap[K, V] {
+	b := &ExpiringBiMap[K, V]{
+		ttl:     ttl,
+		forward: make(map[K]V),
+		inverse: make(map[V]K),
+		expires: make(map[K]time.Time),
+		now:     time.Now,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	if cleanupInterval > 0 {
+		go b.janitor(cleanupInterval)
+	} else {
+		close(b.done)
+	}
+	return b
+}
+
+// Insert puts a key and value into the ExpiringBiMap with the default time-to-live.
+func (b *ExpiringBiMap[K, V]) Insert(k K, v V) {
+	b.InsertWithTTL(k, v, b.ttl)
+}
+
+// InsertWithTTL puts a key and value into the ExpiringBiMap, expiring ttl from now, or never if ttl is not positive.
+// Any existing pairing of k or of v is removed so the map stays a bijection.
+func (b *ExpiringBiMap[K, V]) InsertWithTTL(k K, v V, ttl time.Duration) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if old, ok := b.forward[k]; ok {
+		delete(b.inverse, old)
+	}
+	if owner, ok := b.inverse[v]; ok && owner != k {
+		b.deleteKey(owner)
+	}
+	b.forward[k] = v
+	b.inverse[v] = k
+	if ttl > 0 {
+		b.expires[k] = b.now().Add(ttl)
+	} else {
+		delete(b.expires, k)
+	}
+}
+
+// GetByKey returns the value for a given key and whether or not the element was present and unexpired.
+func (b *ExpiringBiMap[K, V]) GetByKey(k K) (V, bool) {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	v, ok := b.forward[k]
+	if !ok || b.expired(k, b.now()) {
+		var zero V
+		return zero, false
+	}
+	return v, true
+}
+
+// GetByValue returns the key for a given value and whether or not the element was present and unexpired.
+func (b *ExpiringBiMap[K, V]) GetByValue(v V) (K, bool) {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	k, ok := b.inverse[v]
+	if !ok || b.expired(k, b.now()) {
+		var zero K
+		return zero, false
+	}
+	return k, true
+}
+
+// ExistsByKey checks whether or not an unexpired key exists in the ExpiringBiMap.
+func (b *ExpiringBiMap[K, V]) ExistsByKey(k K) bool {
+	_, ok := b.GetByKey(k)
+	return ok
+}
+
+// ExistsByValue checks whether or not an unexpired value exists in the ExpiringBiMap.
+func (b *ExpiringBiMap[K, V]) ExistsByValue(v V) bool {
+	_, ok := b.GetByValue(v)
+	return ok
+}
+
+// ExpiresAt returns when k expires, and false if k is absent, expired or never expires.
+func (b *ExpiringBiMap[K, V]) ExpiresAt(k K) (time.Time, bool) {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	at, ok := b.expires[k]
+	if !ok || !b.now().Before(at) {
+		return time.Time{}, false
+	}
+	return at, true
+}
+
+// DeleteByKey removes a key-value pair from the ExpiringBiMap for a given key. Returns if the key doesn't exist.
+func (b *ExpiringBiMap[K, V]) DeleteByKey(k K) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	b.deleteKey(k)
+}
+
+// DeleteByValue removes a key-value pair from the ExpiringBiMap for a given value. Returns if the value doesn't exist.
+func (b *ExpiringBiMap[K, V]) DeleteByValue(v V) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if k, ok := b.inverse[v]; ok {
+		b.deleteKey(k)
+	}
+}
+
+// Size returns the number of elements in the ExpiringBiMap, including expired ones that have not been purged yet.
+func (b *ExpiringBiMap[K, V]) Size() int {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	return len(b.forward)
+}
+
+// Purge removes expired entries from both directions and returns how many were removed.
+func (b *ExpiringBiMap[K, V]) Purge() int {
+	b.s.Lock()
+	defer b.s.Unlock()
+	now := b.now()
+	purged := 0
+	for k := range b.expires {
+		if b.expired(k, now) {
+			b.deleteKey(k)
+			purged++
+		}
+	}
+	return purged
+}
+
+// Close stops the janitor goroutine, if any, and waits for it to exit. The ExpiringBiMap remains usable,
+// but expired entries are only removed by explicit calls to Purge. Close may be called more than once.
+func (b *ExpiringBiMap[K, V]) Close() {
+	b.closeOnce.Do(func() { close(b.stop) })
+	<-b.done
+}
+
+func (b *ExpiringBiMap[K, V]) janitor(interval time.Duration) {
+	defer close(b.done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			b.Purge()
+		case <-b.stop:
+			return
+		}
+	}
+}
+
+// expired reports whether k has an expiry at or before now. The caller must hold the lock.
+func (b *ExpiringBiMap[K, V]) expired(k K, now time.Time) bool {
+	at, ok := b.expires[k]
+	return ok && !now.Before(at)
+}
+
+// deleteKey removes k from all indexes if present. The caller must hold the lock.
+func (b *ExpiringBiMap[K, V]) deleteKey(k K) {
+	v, ok := b.forward[k]
+	if !ok {
+		return
+	}
+	delete(b.forward, k)
+	delete(b.inverse, v)
+	delete(b.expires, k)
+}
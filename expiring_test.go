@@ -0,0 +1,69 @@
+package bimap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiringBiMap(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	actual := NewExpiringBiMap[string, int](time.Minute, 0)
+	actual.now = func() time.Time { return now }
+
+	actual.Insert("session", 1)
+	actual.InsertWithTTL("short", 2, time.Second)
+	actual.InsertWithTTL("forever", 3, 0)
+
+	at, ok := actual.ExpiresAt("session")
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(time.Minute), at)
+	_, ok = actual.ExpiresAt("forever")
+	assert.False(t, ok, "Entries without a TTL never expire")
+
+	now = now.Add(2 * time.Second)
+	assert.False(t, actual.ExistsByKey("short"), "Expired entries should be invisible")
+	assert.False(t, actual.ExistsByValue(2), "Expired entries should be invisible")
+	assert.True(t, actual.ExistsByKey("session"))
+	assert.Equal(t, 3, actual.Size(), "Expired entries count until purged")
+
+	assert.Equal(t, 1, actual.Purge())
+	assert.Equal(t, 2, actual.Size())
+
+	now = now.Add(time.Hour)
+	assert.Equal(t, 1, actual.Purge())
+	v, ok := actual.GetByKey("forever")
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+}
+
+func TestExpiringBiMap_Bijection(t *testing.T) {
+	actual := NewExpiringBiMap[string, int](time.Minute, 0)
+	actual.Insert("a", 1)
+	actual.Insert("b", 1)
+
+	assert.False(t, actual.ExistsByKey("a"), "Previous owner of the value should be removed")
+	k, _ := actual.GetByValue(1)
+	assert.Equal(t, "b", k)
+
+	actual.DeleteByValue(1)
+	assert.Equal(t, 0, actual.Size())
+}
+
+func TestExpiringBiMap_Janitor(t *testing.T) {
+	actual := NewExpiringBiMap[string, int](time.Millisecond, time.Millisecond)
+	defer actual.Close()
+	actual.Insert("a", 1)
+
+	assert.Eventually(t, func() bool { return actual.Size() == 0 }, time.Second, time.Millisecond)
+}
+
+func TestExpiringBiMap_Close(t *testing.T) {
+	actual := NewExpiringBiMap[string, int](time.Minute, time.Millisecond)
+	actual.Close()
+	actual.Close()
+
+	actual.Insert("a", 1)
+	assert.True(t, actual.ExistsByKey("a"), "ExpiringBiMap should remain usable after Close")
+}
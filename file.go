@@ -0,0 +1,86 @@
+package bimap
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// SaveToFile writes the BiMap to path in the binary snapshot format. The data is written to a temporary file in the
+// same directory, synced, and renamed over path, so a crash leaves either the old file or the new one, never a torn write.
+// The file is created with mode 0644.
+func (b *BiMap[K, V]) SaveToFile(path string) error {
+	data, err := b.MarshalBinary()
+	if err == nil {
+		err = writeFileAtomic(path, func(w io.Writer) error {
+			_, err := w.Write(data)
+			return err
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("bimap: saving %s: %w", path, err)
+	}
+	return nil
+}
+
+// LoadBiMapFromFile reads a file written by SaveToFile and builds a mutable BiMap from it with the given options.
+// It returns ErrDuplicateKey or ErrDuplicateValue if the file does not describe a bijection once keys and values are
+// normalized, and any error from the options' validators or size limit.
+func LoadBiMapFromFile[K comparable, V comparable](path string, opts ...Option) (*BiMap[K, V], error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	forward, _, err := decodeSnapshot[K, V](data)
+	if err != nil {
+		return nil, fmt.Errorf("bimap: loading %s: %w", path, err)
+	}
+	b := NewBiMapWithCapacity[K, V](len(forward), opts...)
+	b.lock()
+	err = b.replaceContents(forward)
+	b.unlock()
+	if err != nil {
+		return nil, fmt.Errorf("bimap: loading %s: %w", path, err)
+	}
+	return b, nil
+}
+
+// writeFileAtomic creates path with the contents written by write, through a synced temporary file and a rename.
+func writeFileAtomic(path string, write func(w io.Writer) error) (err error) {
+	dir, base := filepath.Split(path)
+	if dir == "" {
+		dir = "."
+	}
+	tmp, err := os.CreateTemp(dir, "."+base+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+	if err = write(tmp); err != nil {
+		return err
+	}
+	if err = tmp.Chmod(0o644); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+	// Sync the directory so the rename itself survives a crash. Not all platforms support this, so it is best-effort.
+	if d, derr := os.Open(dir); derr == nil {
+		d.Sync()
+		d.Close()
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package bimap
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap_SaveToFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "codes.bin")
+	src := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	assert.NoError(t, src.SaveToFile(path))
+	src.Insert("c", 3)
+	assert.NoError(t, src.SaveToFile(path), "Saving should replace an existing file")
+
+	loaded, err := LoadBiMapFromFile[string, int](path)
+	assert.NoError(t, err)
+	assert.True(t, loaded.Equal(src))
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1, "No temporary files should be left behind")
+}
+
+func TestLoadBiMapFromFile_Options(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "codes.bin")
+	assert.NoError(t, NewBiMapFromMap(map[string]int{"a": 1, "b": 2}).SaveToFile(path))
+
+	_, err := LoadBiMapFromFile[string, int](path, WithMaxEntries(1))
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+}
+
+func TestLoadBiMapFromFile_NormalizedCollision(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "codes.bin")
+	assert.NoError(t, NewBiMapFromMap(map[string]int{"A": 1, "a": 2}).SaveToFile(path))
+
+	_, err := LoadBiMapFromFile[string, int](path, WithKeyNormalizer(strings.ToLower))
+	assert.ErrorIs(t, err, ErrDuplicateKey, "Entries that normalize to the same key should not overwrite each other")
+
+	assert.NoError(t, NewBiMapFromMap(map[int]string{1: "X", 2: "x"}).SaveToFile(path))
+	_, err = LoadBiMapFromFile[int, string](path, WithValueNormalizer(strings.ToLower))
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+}
+
+func TestLoadBiMapFromFile_Errors(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := LoadBiMapFromFile[string, int](filepath.Join(dir, "missing.bin"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	garbage := filepath.Join(dir, "garbage.bin")
+	assert.NoError(t, os.WriteFile(garbage, []byte("garbage"), 0o644))
+	_, err = LoadBiMapFromFile[string, int](garbage)
+	assert.ErrorContains(t, err, "garbage.bin")
+
+	assert.Error(t, NewBiMap[string, int]().SaveToFile(filepath.Join(dir, "missing", "codes.bin")))
+}
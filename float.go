@@ -0,0 +1,70 @@
+package bimap
+
+import "math"
+
+// Float is the constraint for value types supported by the approximate lookups.
+type Float interface {
+	~float32 | ~float64
+}
+
+// GetByValueNear returns the key and value of the entry whose value is closest to v, provided it is within epsilon.
+// An exact match, including an infinity, is found without scanning; otherwise every entry is scanned, so it costs
+// O(n). Of two entries equally close to v, the smaller is chosen. NaN values never match.
+func GetByValueNear[K comparable, V Float](b *BiMap[K, V], v, epsilon V) (K, V, bool) {
+	b.rlock()
+	defer b.runlock()
+	return nearestValueWithin(b.inverse, v, epsilon)
+}
+
+// GetByNearestValue returns the key and value of the entry whose value is closest to v, and false if the BiMap
+// has no comparable values. An exact match, including an infinity, is found without scanning; otherwise every entry
+// is scanned, so it costs O(n). Of two entries equally close to v, the smaller is chosen. NaN values never match.
+func GetByNearestValue[K comparable, V Float](b *BiMap[K, V], v V) (K, V, bool) {
+	b.rlock()
+	defer b.runlock()
+	return nearestValue(b.inverse, v)
+}
+
+// ImmutableGetByValueNear is GetByValueNear for an ImmutableBiMap.
+func ImmutableGetByValueNear[K comparable, V Float](b *ImmutableBiMap[K, V], v, epsilon V) (K, V, bool) {
+	return nearestValueWithin(b.inverse, v, epsilon)
+}
+
+// ImmutableGetByNearestValue is GetByNearestValue for an ImmutableBiMap.
+func ImmutableGetByNearestValue[K comparable, V Float](b *ImmutableBiMap[K, V], v V) (K, V, bool) {
+	return nearestValue(b.inverse, v)
+}
+
+func nearestValue[K comparable, V Float](inverse map[V]K, v V) (K, V, bool) {
+	var bestK K
+	var bestV V
+	if math.IsNaN(float64(v)) {
+		return bestK, bestV, false
+	}
+	if k, ok := inverse[v]; ok {
+		return k, v, true
+	}
+	var best float64
+	found := false
+	for candidate, k := range inverse {
+		// Subtracting in float64 keeps far-apart float32 values from overflowing to infinity.
+		d := math.Abs(float64(candidate) - float64(v))
+		if math.IsNaN(d) {
+			continue
+		}
+		if !found || d < best || (d == best && candidate < bestV) {
+			best, bestK, bestV, found = d, k, candidate, true
+		}
+	}
+	return bestK, bestV, found
+}
+
+func nearestValueWithin[K comparable, V Float](inverse map[V]K, v, epsilon V) (K, V, bool) {
+	k, nearest, ok := nearestValue(inverse, v)
+	if !ok || (nearest != v && math.Abs(float64(nearest)-float64(v)) > float64(epsilon)) {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	return k, nearest, true
+}
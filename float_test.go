@@ -0,0 +1,76 @@
+package bimap
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetByValueNear(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]float64{"low": 0.1, "mid": 0.5, "high": 0.9})
+
+	k, v, ok := GetByValueNear(actual, 0.1+0.2-0.2, 1e-9)
+	assert.True(t, ok, "Rounding error should be tolerated")
+	assert.Equal(t, "low", k)
+	assert.Equal(t, 0.1, v)
+
+	_, _, ok = GetByValueNear(actual, 0.3, 0.1)
+	assert.False(t, ok, "Values outside epsilon should not match")
+}
+
+func TestGetByNearestValue(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]float64{"low": 0.1, "mid": 0.5, "nan": math.NaN()})
+
+	k, v, ok := GetByNearestValue(actual, 0.4)
+	assert.True(t, ok)
+	assert.Equal(t, "mid", k)
+	assert.Equal(t, 0.5, v)
+
+	_, _, ok = GetByNearestValue(NewBiMap[string, float64](), 0.4)
+	assert.False(t, ok, "Empty map should have no nearest value")
+}
+
+func TestGetByNearestValue_Infinities(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]float64{"low": math.Inf(-1), "high": math.Inf(1), "zero": 0})
+
+	k, _, ok := GetByNearestValue(actual, math.Inf(1))
+	assert.True(t, ok, "An exact infinity should match")
+	assert.Equal(t, "high", k)
+	k, _, ok = GetByValueNear(actual, math.Inf(-1), 0)
+	assert.True(t, ok)
+	assert.Equal(t, "low", k)
+
+	onlyInf := NewBiMapFromMap(map[string]float64{"high": math.Inf(1)})
+	k, _, ok = GetByNearestValue(onlyInf, 1)
+	assert.True(t, ok, "A non-empty map should always have a nearest value")
+	assert.Equal(t, "high", k)
+
+	wide := NewImmutableBiMapFromMap(map[string]float32{"max": math.MaxFloat32})
+	k, _, ok = ImmutableGetByNearestValue(wide, float32(-math.MaxFloat32))
+	assert.True(t, ok, "Distances between far-apart float32 values should not overflow")
+	assert.Equal(t, "max", k)
+}
+
+func TestGetByNearestValue_Ties(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]float64{"a": 1, "b": 3, "c": 5, "d": 7})
+
+	for range 20 {
+		k, v, ok := GetByNearestValue(actual, 2)
+		assert.True(t, ok)
+		assert.Equal(t, "a", k, "Ties should go to the smaller value")
+		assert.Equal(t, 1.0, v)
+	}
+}
+
+func TestImmutableGetByValueNear(t *testing.T) {
+	m := NewImmutableBiMapFromMap(map[string]float32{"a": 1.5, "b": 2.5})
+
+	k, _, ok := ImmutableGetByValueNear(m, float32(1.51), 0.02)
+	assert.True(t, ok)
+	assert.Equal(t, "a", k)
+
+	k, _, ok = ImmutableGetByNearestValue(m, float32(3))
+	assert.True(t, ok)
+	assert.Equal(t, "b", k)
+}
@@ -0,0 +1,52 @@
+package bimap
+
+import (
+	"fmt"
+	"strings"
+)
+
+// String formats the BiMap's pairs sorted by key, as {k1<->v1, k2<->v2}.
+func (b *BiMap[K, V]) String() string {
+	return formatPairs(b.Entries())
+}
+
+// GoString formats the BiMap as a Go expression that rebuilds it, for the %#v verb.
+func (b *BiMap[K, V]) GoString() string {
+	return goStringPairs("bimap.NewBiMapFromMap", b.Entries())
+}
+
+// String formats the ImmutableBiMap's pairs sorted by key, as {k1<->v1, k2<->v2}.
+func (b *ImmutableBiMap[K, V]) String() string {
+	return formatPairs(b.Entries())
+}
+
+// GoString formats the ImmutableBiMap as a Go expression that rebuilds it, for the %#v verb.
+func (b *ImmutableBiMap[K, V]) GoString() string {
+	return goStringPairs("bimap.NewImmutableBiMapFromMap", b.Entries())
+}
+
+func formatPairs[K comparable, V comparable](pairs []Pair[K, V]) string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, p := range pairs {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "%v<->%v", p.Key, p.Value)
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+func goStringPairs[K comparable, V comparable](constructor string, pairs []Pair[K, V]) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s(%T{", constructor, map[K]V(nil))
+	for i, p := range pairs {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "%#v:%#v", p.Key, p.Value)
+	}
+	sb.WriteString("})")
+	return sb.String()
+}
@@ -0,0 +1,29 @@
+package bimap
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap_String(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"b": 2, "a": 1, "c": 3})
+
+	assert.Equal(t, "{a<->1, b<->2, c<->3}", actual.String())
+	assert.Equal(t, "{a<->1, b<->2, c<->3}", fmt.Sprint(actual))
+	assert.Equal(t, "{}", NewBiMap[string, int]().String())
+}
+
+func TestBiMap_GoString(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"b": 2, "a": 1})
+
+	assert.Equal(t, `bimap.NewBiMapFromMap(map[string]int{"a":1, "b":2})`, fmt.Sprintf("%#v", actual))
+}
+
+func TestImmutableBiMap_String(t *testing.T) {
+	m := NewImmutableBiMapFromMap(map[int]string{10: "ten", 2: "two"})
+
+	assert.Equal(t, "{2<->two, 10<->ten}", m.String(), "Numeric keys should sort numerically")
+	assert.Equal(t, `bimap.NewImmutableBiMapFromMap(map[int]string{2:"two", 10:"ten"})`, fmt.Sprintf("%#v", m))
+}
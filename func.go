@@ -0,0 +1,180 @@
+package bimap
+
+import (
+	"hash/maphash"
+	"iter"
+	"slices"
+	"sync"
+)
+
+// BiMapFunc is a thread safe bidirectional map whose keys and values need not be comparable. Entries are located with
+// user-supplied hash and equality functions, so slices, or structs holding maps, can be used as values.
+// Values that are equal must hash to the same value, and stored keys and values must not be modified.
+type BiMapFunc[K any, V any] struct {
+	s          sync.RWMutex
+	hashKey    func(K) uint64
+	equalKey   func(a, b K) bool
+	hashValue  func(V) uint64
+	equalValue func(a, b V) bool
+	forward    map[uint64][]*funcEntry[K, V]
+	inverse    map[uint64][]*funcEntry[K, V]
+	size       int
+}
+
+// funcEntry is a pair stored in a BiMapFunc, shared by both indexes.
+type funcEntry[K any, V any] struct {
+	key   K
+	value V
+}
+
+// NewBiMapFunc returns an empty BiMapFunc with comparable keys and values located with hashValue and equalValue.
+func NewBiMapFunc[K comparable, V any](hashValue func(V) uint64, equalValue func(a, b V) bool) *BiMapFunc[K, V] {
+	seed := maphash.MakeSeed()
+	hashKey := func(k K) uint64 { return maphash.Comparable(seed, k) }
+	equalKey := func(a, b K) bool { return a == b }
+	return NewBiMapFuncs(hashKey, equalKey, hashValue, equalValue)
+}
+
+// NewBiMapFuncs returns an empty BiMapFunc locating keys with hashKey and equalKey and values with hashValue and equalValue.
+func NewBiMapFuncs[K any, V any](hashKey func(K) uint64, equalKey func(a, b K) bool, hashValue func(V) uint64, equalValue func(a, b V) bool) *BiMapFunc[K, V] {
+	return &BiMapFunc[K, V]{
+		hashKey:    hashKey,
+		equalKey:   equalKey,
+		hashValue:  hashValue,
+		equalValue: equalValue,
+		forward:    make(map[uint64][]*funcEntry[K, V]),
+		inverse:    make(map[uint64][]*funcEntry[K, V]),
+	}
+}
+
+// Insert puts a key and value into the BiMapFunc. Any existing pairing of k or of v is removed so the map stays a bijection.
+func (b *BiMapFunc[K, V]) Insert(k K, v V) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if p := b.findKey(k); p != nil {
+		b.remove(p)
+	}
+	if p := b.findValue(v); p != nil {
+		b.remove(p)
+	}
+	p := &funcEntry[K, V]{key: k, value: v}
+	kh, vh := b.hashKey(k), b.hashValue(v)
+	b.forward[kh] = append(b.forward[kh], p)
+	b.inverse[vh] = append(b.inverse[vh], p)
+	b.size++
+}
+
+// GetByKey returns the value for a given key and whether or not the element was present.
+func (b *BiMapFunc[K, V]) GetByKey(k K) (V, bool) {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	if p := b.findKey(k); p != nil {
+		return p.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// GetByValue returns the key for a given value and whether or not the element was present.
+func (b *BiMapFunc[K, V]) GetByValue(v V) (K, bool) {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	if p := b.findValue(v); p != nil {
+		return p.key, true
+	}
+	var zero K
+	return zero, false
+}
+
+// ExistsByKey checks whether or not a key exists in the BiMapFunc.
+func (b *BiMapFunc[K, V]) ExistsByKey(k K) bool {
+	_, ok := b.GetByKey(k)
+	return ok
+}
+
+// ExistsByValue checks whether or not a value exists in the BiMapFunc.
+func (b *BiMapFunc[K, V]) ExistsByValue(v V) bool {
+	_, ok := b.GetByValue(v)
+	return ok
+}
+
+// DeleteByKey removes a key-value pair from the BiMapFunc for a given key. Returns if the key doesn't exist.
+func (b *BiMapFunc[K, V]) DeleteByKey(k K) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if p := b.findKey(k); p != nil {
+		b.remove(p)
+	}
+}
+
+// DeleteByValue removes a key-value pair from the BiMapFunc for a given value. Returns if the value doesn't exist.
+func (b *BiMapFunc[K, V]) DeleteByValue(v V) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if p := b.findValue(v); p != nil {
+		b.remove(p)
+	}
+}
+
+// Size returns the number of elements in the BiMapFunc.
+func (b *BiMapFunc[K, V]) Size() int {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	return b.size
+}
+
+// All returns an iterator over the BiMapFunc's key-value pairs, in no particular order.
+// The read lock is held for the duration of the loop, so the loop body must not modify the BiMapFunc.
+func (b *BiMapFunc[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		b.s.RLock()
+		defer b.s.RUnlock()
+		for _, bucket := range b.forward {
+			for _, p := range bucket {
+				if !yield(p.key, p.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// findKey returns the pair holding k, or nil. The caller must hold the lock.
+func (b *BiMapFunc[K, V]) findKey(k K) *funcEntry[K, V] {
+	for _, p := range b.forward[b.hashKey(k)] {
+		if b.equalKey(p.key, k) {
+			return p
+		}
+	}
+	return nil
+}
+
+// findValue returns the pair holding v, or nil. The caller must hold the lock.
+func (b *BiMapFunc[K, V]) findValue(v V) *funcEntry[K, V] {
+	for _, p := range b.inverse[b.hashValue(v)] {
+		if b.equalValue(p.value, v) {
+			return p
+		}
+	}
+	return nil
+}
+
+// remove unlinks p from both indexes. The caller must hold the write lock.
+func (b *BiMapFunc[K, V]) remove(p *funcEntry[K, V]) {
+	removeFromBucket(b.forward, b.hashKey(p.key), p)
+	removeFromBucket(b.inverse, b.hashValue(p.value), p)
+	b.size--
+}
+
+func removeFromBucket[K any, V any](index map[uint64][]*funcEntry[K, V], h uint64, p *funcEntry[K, V]) {
+	bucket := index[h]
+	i := slices.Index(bucket, p)
+	if i < 0 {
+		return
+	}
+	if len(bucket) == 1 {
+		delete(index, h)
+		return
+	}
+	index[h] = slices.Delete(bucket, i, i+1)
+}
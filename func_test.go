@@ -0,0 +1,59 @@
+package bimap
+
+import (
+	"hash/maphash"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testSeed = maphash.MakeSeed()
+
+func hashStrings(s []string) uint64 { return maphash.String(testSeed, strings.Join(s, "\x00")) }
+
+func TestBiMapFunc(t *testing.T) {
+	actual := NewBiMapFunc[string](hashStrings, slices.Equal[[]string])
+	actual.Insert("ab", []string{"a", "b"})
+	actual.Insert("cd", []string{"c", "d"})
+
+	v, ok := actual.GetByKey("ab")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a", "b"}, v)
+
+	k, ok := actual.GetByValue([]string{"c", "d"})
+	assert.True(t, ok, "Equal slices should find the entry")
+	assert.Equal(t, "cd", k)
+	assert.False(t, actual.ExistsByValue([]string{"c"}))
+	assert.Equal(t, 2, actual.Size())
+
+	actual.Insert("ab2", []string{"a", "b"})
+	assert.False(t, actual.ExistsByKey("ab"), "Inserting an existing value should remove its old key")
+	actual.Insert("cd", []string{"x"})
+	assert.False(t, actual.ExistsByValue([]string{"c", "d"}), "Replacing a key's value should remove the old value")
+	assert.Equal(t, 2, actual.Size())
+
+	actual.DeleteByValue([]string{"x"})
+	actual.DeleteByKey("missing")
+	assert.Equal(t, map[string][]string{"ab2": {"a", "b"}}, maps.Collect(actual.All()))
+}
+
+func TestBiMapFuncs_Collisions(t *testing.T) {
+	constant := func([]int) uint64 { return 1 }
+	actual := NewBiMapFuncs(constant, slices.Equal[[]int], constant, slices.Equal[[]int])
+	actual.Insert([]int{1}, []int{10})
+	actual.Insert([]int{2}, []int{20})
+	actual.Insert([]int{3}, []int{30})
+
+	v, ok := actual.GetByKey([]int{2})
+	assert.True(t, ok, "Colliding hashes should be told apart by equality")
+	assert.Equal(t, []int{20}, v)
+
+	actual.DeleteByKey([]int{2})
+	assert.False(t, actual.ExistsByKey([]int{2}))
+	assert.True(t, actual.ExistsByKey([]int{1}))
+	assert.True(t, actual.ExistsByValue([]int{30}))
+	assert.Equal(t, 2, actual.Size())
+}
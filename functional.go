@@ -0,0 +1,108 @@
+package bimap
+
+import "fmt"
+
+// Filter returns a new, mutable BiMap holding the pairs for which pred returns true, with the same options as the
+// BiMap. pred runs under the read lock and must not call methods on the BiMap.
+func (b *BiMap[K, V]) Filter(pred func(k K, v V) bool) *BiMap[K, V] {
+	b.rlock()
+	defer b.runlock()
+	forward := make(map[K]V)
+	inverse := make(map[V]K)
+	for k, v := range b.forward {
+		if pred(k, v) {
+			forward[k] = v
+			inverse[v] = k
+		}
+	}
+	return b.derive(forward, inverse)
+}
+
+// CountWhere returns how many pairs pred returns true for. pred runs under the read lock and must not call methods
+// on the BiMap.
+func (b *BiMap[K, V]) CountWhere(pred func(k K, v V) bool) int {
+	b.rlock()
+	defer b.runlock()
+	n := 0
+	for k, v := range b.forward {
+		if pred(k, v) {
+			n++
+		}
+	}
+	return n
+}
+
+// Any reports whether pred returns true for at least one pair, stopping at the first match. pred runs under the
+// read lock and must not call methods on the BiMap.
+func (b *BiMap[K, V]) Any(pred func(k K, v V) bool) bool {
+	b.rlock()
+	defer b.runlock()
+	for k, v := range b.forward {
+		if pred(k, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Every reports whether pred returns true for every pair, stopping at the first mismatch, and is true for an empty
+// BiMap. It is not named All, which iterates over the pairs. pred runs under the read lock and must not call methods
+// on the BiMap.
+func (b *BiMap[K, V]) Every(pred func(k K, v V) bool) bool {
+	b.rlock()
+	defer b.runlock()
+	for k, v := range b.forward {
+		if !pred(k, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// MapValues returns a new BiMap pairing each key of b with fn applied to its value. It returns ErrDuplicateValue if
+// fn maps two values to the same result. Options are not carried over, as the value type may differ.
+func MapValues[K comparable, V comparable, V2 comparable](b *BiMap[K, V], fn func(V) V2) (*BiMap[K, V2], error) {
+	b.rlock()
+	defer b.runlock()
+	forward := make(map[K]V2, len(b.forward))
+	inverse := make(map[V2]K, len(b.forward))
+	for k, v := range b.forward {
+		mapped := fn(v)
+		if other, ok := inverse[mapped]; ok {
+			return nil, fmt.Errorf("%w: %v produced by both %v and %v", ErrDuplicateValue, mapped, other, k)
+		}
+		forward[k] = mapped
+		inverse[mapped] = k
+	}
+	return &BiMap[K, V2]{forward: forward, inverse: inverse}, nil
+}
+
+// MapKeys returns a new BiMap pairing fn applied to each key of b with its value. It returns ErrDuplicateKey if
+// fn maps two keys to the same result. Options are not carried over, as the key type may differ.
+func MapKeys[K comparable, V comparable, K2 comparable](b *BiMap[K, V], fn func(K) K2) (*BiMap[K2, V], error) {
+	b.rlock()
+	defer b.runlock()
+	forward := make(map[K2]V, len(b.forward))
+	inverse := make(map[V]K2, len(b.forward))
+	for k, v := range b.forward {
+		mapped := fn(k)
+		if _, ok := forward[mapped]; ok {
+			return nil, fmt.Errorf("%w: %v produced by more than one key", ErrDuplicateKey, mapped)
+		}
+		forward[mapped] = v
+		inverse[v] = mapped
+	}
+	return &BiMap[K2, V]{forward: forward, inverse: inverse}, nil
+}
+
+// Reduce folds fn over the pairs of b, in no particular order, starting from initial. fn runs under the read lock
+// and must not call methods on b.
+func Reduce[K comparable, V comparable, A any](b *BiMap[K, V], initial A, fn func(acc A, k K, v V) A) A {
+	b.rlock()
+	defer b.runlock()
+	acc := initial
+	for k, v := range b.forward {
+		acc = fn(acc, k, v)
+	}
+	return acc
+}
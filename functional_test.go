@@ -0,0 +1,79 @@
+package bimap
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+func TestBiMap_Filter(t *testing.T) {
+	src := NewBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3}, WithMaxEntries(3))
+
+	actual := src.Filter(func(k string, v int) bool { return v%2 == 1 })
+	assert.Equal(t, map[string]int{"a": 1, "c": 3}, actual.SnapshotForward())
+	assert.Equal(t, map[int]string{1: "a", 3: "c"}, actual.SnapshotInverse())
+	assert.Equal(t, 3, src.Size(), "Source should be unaffected")
+	actual.Insert("d", 4)
+	assert.ErrorIs(t, actual.TryInsert("e", 5), ErrQuotaExceeded, "Filtered BiMap should keep the source's options")
+}
+
+func TestBiMap_FilterCollation(t *testing.T) {
+	src := NewBiMap[string, int](WithCollator(language.English, collate.IgnoreCase))
+	src.Insert("Apple", 1)
+
+	actual := src.Filter(func(string, int) bool { return true })
+	v, ok := actual.GetByKey("APPLE")
+	assert.True(t, ok, "Filtered BiMap should keep collation")
+	assert.Equal(t, 1, v)
+}
+
+func TestBiMap_Predicates(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	even := func(_ string, v int) bool { return v%2 == 0 }
+	positive := func(_ string, v int) bool { return v > 0 }
+
+	assert.Equal(t, 1, actual.CountWhere(even))
+	assert.Equal(t, 3, actual.CountWhere(positive))
+	assert.True(t, actual.Any(even))
+	assert.False(t, actual.Any(func(_ string, v int) bool { return v > 3 }))
+	assert.True(t, actual.Every(positive))
+	assert.False(t, actual.Every(even))
+
+	empty := NewBiMap[string, int]()
+	assert.Equal(t, 0, empty.CountWhere(positive))
+	assert.False(t, empty.Any(positive))
+	assert.True(t, empty.Every(even), "Every should hold vacuously for an empty BiMap")
+}
+
+func TestMapValues(t *testing.T) {
+	src := NewBiMapFromMap(map[string]string{"a": "x", "b": "y"})
+
+	actual, err := MapValues(src, strings.ToUpper)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "X", "b": "Y"}, actual.SnapshotForward())
+	assert.Equal(t, map[string]string{"X": "a", "Y": "b"}, actual.SnapshotInverse())
+
+	_, err = MapValues(src, func(string) int { return 0 })
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+}
+
+func TestMapKeys(t *testing.T) {
+	src := NewBiMapFromMap(map[string]int{"a": 1, "bb": 2})
+
+	actual, err := MapKeys(src, func(k string) string { return k + "!" })
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"a!": 1, "bb!": 2}, actual.SnapshotForward())
+	assert.Equal(t, map[int]string{1: "a!", 2: "bb!"}, actual.SnapshotInverse())
+
+	_, err = MapKeys(src, func(string) bool { return true })
+	assert.ErrorIs(t, err, ErrDuplicateKey)
+}
+
+func TestReduce(t *testing.T) {
+	src := NewBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	assert.Equal(t, 6, Reduce(src, 0, func(sum int, _ string, v int) int { return sum + v }))
+}
@@ -0,0 +1,87 @@
+package bimap
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+)
+
+// gobStreamVersion identifies the layout of the stream written by EncodeGob.
+const gobStreamVersion = 1
+
+// gobStreamHeader precedes the entries of a stream written by EncodeGob.
+type gobStreamHeader struct {
+	Version int
+	Count   int
+}
+
+// gobStreamEntry is one pair of a stream written by EncodeGob.
+type gobStreamEntry[K comparable, V comparable] struct {
+	Key   K
+	Value V
+}
+
+// maxGobPresize caps how many entries DecodeGob allocates for up front, so a corrupt count cannot exhaust memory.
+const maxGobPresize = 1 << 16
+
+// EncodeGob streams the BiMap to w as a gob header followed by one gob value per pair, so memory use stays bounded
+// however large the BiMap is. The read lock is held while writing, so writers wait until the stream is complete.
+func (b *BiMap[K, V]) EncodeGob(w io.Writer) error {
+	b.rlock()
+	defer b.runlock()
+	enc := gob.NewEncoder(w)
+	if err := enc.Encode(gobStreamHeader{Version: gobStreamVersion, Count: len(b.forward)}); err != nil {
+		return err
+	}
+	var entry gobStreamEntry[K, V]
+	for k, v := range b.forward {
+		entry.Key, entry.Value = k, v
+		if err := enc.Encode(&entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeGob reads a stream written by EncodeGob into the BiMap, replacing its contents as UnmarshalJSON does. It
+// returns ErrDuplicateKey or ErrDuplicateValue if the stream does not describe a bijection, ErrImmutable if the BiMap
+// is immutable, or the first insert error, leaving the BiMap unchanged. The decoder may read past the end of the stream if r is not an io.ByteReader.
+func (b *BiMap[K, V]) DecodeGob(r io.Reader) error {
+	dec := gob.NewDecoder(r)
+	var h gobStreamHeader
+	if err := dec.Decode(&h); err != nil {
+		return err
+	}
+	if h.Version != gobStreamVersion {
+		return fmt.Errorf("bimap: unsupported gob stream version %d", h.Version)
+	}
+	forward := make(map[K]V, min(h.Count, maxGobPresize))
+	inverse := make(map[V]K, min(h.Count, maxGobPresize))
+	for range h.Count {
+		var entry gobStreamEntry[K, V]
+		if err := dec.Decode(&entry); err != nil {
+			return err
+		}
+		if _, ok := forward[entry.Key]; ok {
+			return fmt.Errorf("%w: %v", ErrDuplicateKey, entry.Key)
+		}
+		if other, ok := inverse[entry.Value]; ok {
+			return fmt.Errorf("%w: %v held by both %v and %v", ErrDuplicateValue, entry.Value, other, entry.Key)
+		}
+		forward[entry.Key] = entry.Value
+		inverse[entry.Value] = entry.Key
+	}
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return ErrImmutable
+	}
+	return b.replaceContents(forward)
+}
+
+// RegisterGob registers *BiMap[K, V] and *ImmutableBiMap[K, V] with encoding/gob, which is needed to gob-encode
+// them when they are held in interface values.
+func RegisterGob[K comparable, V comparable]() {
+	gob.Register(&BiMap[K, V]{})
+	gob.Register(&ImmutableBiMap[K, V]{})
+}
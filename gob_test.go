@@ -0,0 +1,72 @@
+package bimap
+
+import (
+	"bytes"
+	"encoding/gob"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap_EncodeGob(t *testing.T) {
+	src := NewBiMap[string, int]()
+	for i := range 1000 {
+		src.Insert(string(rune('a'+i%26))+string(rune('0'+i/26)), i)
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, src.EncodeGob(&buf))
+
+	actual := NewBiMap[string, int]()
+	actual.Insert("stale", -1)
+	assert.NoError(t, actual.DecodeGob(&buf))
+	assert.True(t, src.Equal(actual), "Decoding should replace the contents with the stream's pairs")
+}
+
+func TestBiMap_DecodeGob_Errors(t *testing.T) {
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	assert.NoError(t, enc.Encode(gobStreamHeader{Version: gobStreamVersion, Count: 2}))
+	assert.NoError(t, enc.Encode(gobStreamEntry[string, int]{Key: "a", Value: 1}))
+	assert.NoError(t, enc.Encode(gobStreamEntry[string, int]{Key: "b", Value: 1}))
+
+	actual := NewBiMapFromMap(map[string]int{"x": 9})
+	assert.ErrorIs(t, actual.DecodeGob(&buf), ErrDuplicateValue)
+	assert.Equal(t, map[string]int{"x": 9}, actual.SnapshotForward(), "Failed decodes should leave the BiMap unchanged")
+
+	buf.Reset()
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(gobStreamHeader{Version: 99}))
+	assert.ErrorContains(t, actual.DecodeGob(&buf), "unsupported gob stream version 99")
+
+	buf.Reset()
+	assert.NoError(t, NewBiMap[string, int]().EncodeGob(&buf))
+	actual.MakeImmutable()
+	assert.ErrorIs(t, actual.DecodeGob(&buf), ErrImmutable)
+}
+
+func TestBiMap_DecodeGob_Options(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, NewBiMapFromMap(map[string]int{"a": 1, "A": 2}).EncodeGob(&buf))
+	data := buf.Bytes()
+
+	folded := NewBiMap[string, int](WithKeyNormalizer(strings.ToLower))
+	assert.ErrorIs(t, folded.DecodeGob(bytes.NewReader(data)), ErrDuplicateKey, "Keys colliding once normalized should be rejected")
+	assert.Equal(t, 0, folded.Size())
+
+	limited := NewBiMap[string, int](WithMaxEntries(1))
+	assert.ErrorIs(t, limited.DecodeGob(bytes.NewReader(data)), ErrQuotaExceeded)
+	assert.Equal(t, 0, limited.Size())
+}
+
+func TestRegisterGob(t *testing.T) {
+	RegisterGob[string, int]()
+
+	var buf bytes.Buffer
+	var in any = NewBiMapFromMap(map[string]int{"a": 1})
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(&in))
+
+	var out any
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&out))
+	assert.Equal(t, map[string]int{"a": 1}, out.(*BiMap[string, int]).SnapshotForward())
+}
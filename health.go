@@ -0,0 +1,118 @@
+package bimap
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// healthSampleLimit is the number of entries per direction HealthCheck inspects; larger maps are sampled.
+const healthSampleLimit = 100_000
+
+// HealthReport summarizes the state of a BiMap for health endpoints.
+type HealthReport struct {
+	// Size is the number of entries in the forward index.
+	Size int
+	// InverseSize is the number of entries in the inverse index. It differs from Size when the indexes are out of sync.
+	InverseSize int
+	// Sampled reports whether only part of the map was checked because it is larger than the sample limit.
+	Sampled bool
+	// LastMutation is the time of the most recent write, or zero unless the BiMap was built with WithHealthTracking.
+	LastMutation time.Time
+	// Problems describes each forward/inverse asymmetry found.
+	Problems []string
+}
+
+// Healthy reports whether no problems were found.
+func (r HealthReport) Healthy() bool {
+	return len(r.Problems) == 0
+}
+
+// Health checks that the forward and inverse indexes mirror each other, inspecting a sample of each when the map
+// is large, and reports the result along with the map's size and, if tracked, the time of its last mutation.
+func (b *BiMap[K, V]) Health() HealthReport {
+	b.rlock()
+	defer b.runlock()
+	report := HealthReport{
+		Size:        len(b.forward),
+		InverseSize: len(b.inverse),
+		Sampled:     len(b.forward) > healthSampleLimit || len(b.inverse) > healthSampleLimit,
+		Problems:    b.asymmetries(healthSampleLimit),
+	}
+	if b.lastMutation != nil {
+		report.LastMutation = *b.lastMutation
+	}
+	return report
+}
+
+// HealthCheck returns an error wrapping ErrCorrupted if Health finds any forward/inverse asymmetry, and nil otherwise.
+// It is suitable for wiring into health endpoints.
+func (b *BiMap[K, V]) HealthCheck() error {
+	report := b.Health()
+	if report.Healthy() {
+		return nil
+	}
+	detail := fmt.Sprintf("%d forward and %d inverse entries", report.Size, report.InverseSize)
+	if !report.LastMutation.IsZero() {
+		detail += fmt.Sprintf(", last mutation %s ago", time.Since(report.LastMutation).Round(time.Millisecond))
+	}
+	return fmt.Errorf("%w: %s: %s", ErrCorrupted, detail, strings.Join(report.Problems, "; "))
+}
+
+// Validate checks every entry, unlike HealthCheck's sample, and returns an error wrapping ErrCorrupted that lists
+// each dangling forward or inverse entry if the indexes are not exact mirrors. It is meant as an invariant check in
+// tests and after loading data from outside.
+func (b *BiMap[K, V]) Validate() error {
+	b.rlock()
+	problems := b.asymmetries(0)
+	b.runlock()
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrCorrupted, strings.Join(problems, "; "))
+}
+
+// touch records the time of a mutation if health tracking is enabled, reports the new size to the Collector, if
+// any, and wakes WaitForKey callers whose key is now present. The caller must hold the lock.
+func (b *BiMap[K, V]) touch() {
+	if b.lastMutation != nil {
+		*b.lastMutation = time.Now()
+	}
+	if b.metrics != nil {
+		b.metrics.SizeChanged(len(b.forward))
+	}
+	if len(b.waiters) > 0 {
+		b.wakeWaiters()
+	}
+}
+
+// asymmetries describes forward entries not mirrored by the inverse index and vice versa, inspecting at most
+// limit entries per direction, or all of them if limit is not positive. The caller must hold the lock.
+// Map iteration order is randomized, so each call inspects a different sample of large maps.
+func (b *BiMap[K, V]) asymmetries(limit int) []string {
+	var problems []string
+	if len(b.forward) != len(b.inverse) {
+		problems = append(problems, fmt.Sprintf("forward has %d entries but inverse has %d", len(b.forward), len(b.inverse)))
+	}
+	n := 0
+	for k, v := range b.forward {
+		if limit > 0 && n >= limit {
+			break
+		}
+		n++
+		if back, ok := b.inverse[v]; !ok || back != k {
+			problems = append(problems, fmt.Sprintf("key %v maps to %v but %v does not map back", k, v, v))
+		}
+	}
+	n = 0
+	for v, k := range b.inverse {
+		if limit > 0 && n >= limit {
+			break
+		}
+		n++
+		if fwd, ok := b.forward[k]; !ok || fwd != v {
+			problems = append(problems, fmt.Sprintf("value %v maps back to %v but %v does not map to it", v, k, k))
+		}
+	}
+	return problems
+}
@@ -0,0 +1,59 @@
+package bimap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap_HealthCheck(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	assert.NoError(t, actual.HealthCheck())
+	report := actual.Health()
+	assert.True(t, report.Healthy())
+	assert.Equal(t, 2, report.Size)
+	assert.False(t, report.Sampled)
+	assert.True(t, report.LastMutation.IsZero(), "Mutations should not be tracked by default")
+}
+
+func TestBiMap_HealthCheck_Corrupted(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	actual.Insert("a", 1)
+	actual.Insert("b", 1) // leaves "a" -> 1 without an inverse entry
+
+	err := actual.HealthCheck()
+	assert.ErrorIs(t, err, ErrCorrupted)
+	assert.Contains(t, err.Error(), "key a maps to 1")
+
+	report := actual.Health()
+	assert.False(t, report.Healthy())
+	assert.Equal(t, 2, report.Size)
+	assert.Equal(t, 1, report.InverseSize)
+}
+
+func TestBiMap_Validate(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+	assert.NoError(t, actual.Validate())
+
+	actual.Insert("c", 1) // leaves "a" -> 1 without an inverse entry
+	err := actual.Validate()
+	assert.ErrorIs(t, err, ErrCorrupted)
+	assert.Contains(t, err.Error(), "forward has 3 entries but inverse has 2")
+	assert.Contains(t, err.Error(), "key a maps to 1")
+}
+
+func TestWithHealthTracking(t *testing.T) {
+	actual := NewBiMap[string, int](WithHealthTracking())
+	assert.True(t, actual.Health().LastMutation.IsZero())
+
+	before := time.Now()
+	actual.Insert("a", 1)
+	last := actual.Health().LastMutation
+	assert.False(t, last.Before(before), "Insert should be recorded")
+
+	time.Sleep(time.Millisecond)
+	actual.DeleteByKey("a")
+	assert.True(t, actual.Health().LastMutation.After(last), "Delete should be recorded")
+}
@@ -0,0 +1,73 @@
+package bimap
+
+// hooks holds the change listeners and watchers registered on a BiMap.
+type hooks[K comparable, V comparable] struct {
+	insert   []func(K, V)
+	delete   []func(K, V)
+	replace  []func(K, V, V)
+	watchers []*watcher[K, V]
+}
+
+// OnInsert registers fn to be called whenever a new key is added to the BiMap.
+//
+// Listeners run synchronously, in registration order, after the change has been applied but while the write lock
+// is still held. This guarantees they observe changes in the order they happen, so secondary indexes stay in sync,
+// but they must be quick and must not call methods on the BiMap.
+func (b *BiMap[K, V]) OnInsert(fn func(k K, v V)) {
+	b.lock()
+	defer b.unlock()
+	b.listeners().insert = append(b.listeners().insert, fn)
+}
+
+// OnDelete registers fn to be called whenever a pair is removed from the BiMap. See OnInsert for when listeners run.
+func (b *BiMap[K, V]) OnDelete(fn func(k K, v V)) {
+	b.lock()
+	defer b.unlock()
+	b.listeners().delete = append(b.listeners().delete, fn)
+}
+
+// OnReplace registers fn to be called whenever an existing key is mapped to a new value. See OnInsert for when
+// listeners run.
+func (b *BiMap[K, V]) OnReplace(fn func(k K, oldV, newV V)) {
+	b.lock()
+	defer b.unlock()
+	b.listeners().replace = append(b.listeners().replace, fn)
+}
+
+// listeners returns the BiMap's hooks, allocating them on first use. The caller must hold the lock.
+func (b *BiMap[K, V]) listeners() *hooks[K, V] {
+	if b.hooks == nil {
+		b.hooks = &hooks[K, V]{}
+	}
+	return b.hooks
+}
+
+func (b *BiMap[K, V]) fireInsert(k K, v V) {
+	if b.hooks == nil {
+		return
+	}
+	for _, fn := range b.hooks.insert {
+		fn(k, v)
+	}
+	b.notifyWatchers(ChangeEvent[K, V]{Op: ChangeInsert, Key: k, NewValue: v})
+}
+
+func (b *BiMap[K, V]) fireDelete(k K, v V) {
+	if b.hooks == nil {
+		return
+	}
+	for _, fn := range b.hooks.delete {
+		fn(k, v)
+	}
+	b.notifyWatchers(ChangeEvent[K, V]{Op: ChangeDelete, Key: k, OldValue: v})
+}
+
+func (b *BiMap[K, V]) fireReplace(k K, oldV, newV V) {
+	if b.hooks == nil {
+		return
+	}
+	for _, fn := range b.hooks.replace {
+		fn(k, oldV, newV)
+	}
+	b.notifyWatchers(ChangeEvent[K, V]{Op: ChangeReplace, Key: k, OldValue: oldV, NewValue: newV})
+}
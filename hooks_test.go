@@ -0,0 +1,57 @@
+package bimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap_Hooks(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	var events []string
+	actual.OnInsert(func(k string, v int) { events = append(events, "insert "+k) })
+	actual.OnReplace(func(k string, oldV, newV int) {
+		assert.Equal(t, 1, oldV)
+		assert.Equal(t, 2, newV)
+		events = append(events, "replace "+k)
+	})
+	actual.OnDelete(func(k string, v int) { events = append(events, "delete "+k) })
+
+	actual.Insert("a", 1)
+	actual.Insert("a", 2)
+	actual.DeleteByValue(2)
+	actual.DeleteByKey("missing")
+
+	assert.Equal(t, []string{"insert a", "replace a", "delete a"}, events)
+}
+
+func TestBiMap_HooksSecondaryIndex(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	index := make(map[int]string)
+	actual.OnInsert(func(k string, v int) { index[v] = k })
+	actual.OnReplace(func(k string, oldV, newV int) {
+		delete(index, oldV)
+		index[newV] = k
+	})
+	actual.OnDelete(func(k string, v int) { delete(index, v) })
+
+	actual.InsertAll(map[string]int{"a": 1, "b": 2, "c": 3})
+	actual.Insert("a", 10)
+	actual.DeleteKeys("b")
+	assert.NoError(t, actual.SwapValues("a", "c"))
+	assert.Equal(t, actual.SnapshotInverse(), index)
+
+	assert.NoError(t, actual.UnmarshalJSON([]byte(`{"x":7}`)))
+	assert.Equal(t, actual.SnapshotInverse(), index, "Wholesale replacement should fire deletes and inserts")
+
+	actual.Clear()
+	assert.Empty(t, index)
+}
+
+func TestBiMap_HooksDroppedOnReset(t *testing.T) {
+	b := NewBiMap[string, int]()
+	b.OnInsert(func(string, int) { t.Fatal("Pooled BiMaps should not keep listeners") })
+	b.reset()
+
+	b.Insert("a", 1)
+}
@@ -1,5 +1,11 @@
 package bimap
 
+import (
+	"fmt"
+	"iter"
+	"maps"
+)
+
 // ImmutableBiMap is a read-only bidirectional map. Safe for concurrent use
 // without any locking — the data never changes after construction.
 type ImmutableBiMap[K comparable, V comparable] struct {
@@ -18,6 +24,24 @@ func NewImmutableBiMapFromMap[K, V comparable](forwardMap map[K]V) *ImmutableBiM
 	return &ImmutableBiMap[K, V]{forward: forward, inverse: inverse}
 }
 
+// NewImmutableBiMapFromSeq builds an ImmutableBiMap from the pairs yielded by seq, with both directions pre-sized
+// for n entries. It returns ErrDuplicateKey or ErrDuplicateValue if seq does not describe a bijection.
+func NewImmutableBiMapFromSeq[K, V comparable](seq iter.Seq2[K, V], n int) (*ImmutableBiMap[K, V], error) {
+	forward := make(map[K]V, n)
+	inverse := make(map[V]K, n)
+	for k, v := range seq {
+		if _, ok := forward[k]; ok {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateKey, k)
+		}
+		if other, ok := inverse[v]; ok {
+			return nil, fmt.Errorf("%w: %v held by both %v and %v", ErrDuplicateValue, v, other, k)
+		}
+		forward[k] = v
+		inverse[v] = k
+	}
+	return &ImmutableBiMap[K, V]{forward: forward, inverse: inverse}, nil
+}
+
 // GetByKey returns the value for a given key and whether or not the element was present.
 func (b *ImmutableBiMap[K, V]) GetByKey(k K) (V, bool) {
 	v, ok := b.forward[k]
@@ -80,3 +104,157 @@ func (b *ImmutableBiMap[K, V]) GetInverseMap() map[V]K {
 	}
 	return m
 }
+
+// WithAll returns a new ImmutableBiMap with the given pairs added, replacing the values of keys already present.
+// It returns ErrDuplicateValue if a value would end up held by two keys. The receiver is unaffected.
+func (b *ImmutableBiMap[K, V]) WithAll(pairs map[K]V) (*ImmutableBiMap[K, V], error) {
+	forward := make(map[K]V, len(b.forward)+len(pairs))
+	inverse := make(map[V]K, len(b.forward)+len(pairs))
+	for k, v := range b.forward {
+		if _, replaced := pairs[k]; replaced {
+			continue
+		}
+		forward[k] = v
+		inverse[v] = k
+	}
+	for k, v := range pairs {
+		if other, ok := inverse[v]; ok {
+			return nil, fmt.Errorf("%w: %v held by both %v and %v", ErrDuplicateValue, v, other, k)
+		}
+		forward[k] = v
+		inverse[v] = k
+	}
+	return &ImmutableBiMap[K, V]{forward: forward, inverse: inverse}, nil
+}
+
+// WithoutKeys returns a new ImmutableBiMap without the given keys. Keys that are not present are ignored.
+// The receiver is unaffected.
+func (b *ImmutableBiMap[K, V]) WithoutKeys(keys ...K) *ImmutableBiMap[K, V] {
+	skip := make(map[K]struct{}, len(keys))
+	for _, k := range keys {
+		skip[k] = struct{}{}
+	}
+	forward := make(map[K]V, len(b.forward))
+	inverse := make(map[V]K, len(b.forward))
+	for k, v := range b.forward {
+		if _, ok := skip[k]; ok {
+			continue
+		}
+		forward[k] = v
+		inverse[v] = k
+	}
+	return &ImmutableBiMap[K, V]{forward: forward, inverse: inverse}
+}
+
+// Union returns a new ImmutableBiMap holding the pairs of both maps. It returns ErrDuplicateKey or
+// ErrDuplicateValue if the maps pair a key or value differently.
+func (b *ImmutableBiMap[K, V]) Union(other *ImmutableBiMap[K, V]) (*ImmutableBiMap[K, V], error) {
+	forward := make(map[K]V, len(b.forward)+len(other.forward))
+	inverse := make(map[V]K, len(b.forward)+len(other.forward))
+	for k, v := range b.forward {
+		forward[k] = v
+		inverse[v] = k
+	}
+	for k, v := range other.forward {
+		if existing, ok := forward[k]; ok && existing != v {
+			return nil, fmt.Errorf("%w: %v maps to both %v and %v", ErrDuplicateKey, k, existing, v)
+		}
+		if existing, ok := inverse[v]; ok && existing != k {
+			return nil, fmt.Errorf("%w: %v held by both %v and %v", ErrDuplicateValue, v, existing, k)
+		}
+		forward[k] = v
+		inverse[v] = k
+	}
+	return &ImmutableBiMap[K, V]{forward: forward, inverse: inverse}, nil
+}
+
+// Intersect returns a new ImmutableBiMap holding the pairs present in both maps.
+func (b *ImmutableBiMap[K, V]) Intersect(other *ImmutableBiMap[K, V]) *ImmutableBiMap[K, V] {
+	forward := make(map[K]V)
+	inverse := make(map[V]K)
+	for k, v := range b.forward {
+		if ov, ok := other.forward[k]; ok && ov == v {
+			forward[k] = v
+			inverse[v] = k
+		}
+	}
+	return &ImmutableBiMap[K, V]{forward: forward, inverse: inverse}
+}
+
+// Difference returns a new ImmutableBiMap holding the pairs of b that are not present in other.
+func (b *ImmutableBiMap[K, V]) Difference(other *ImmutableBiMap[K, V]) *ImmutableBiMap[K, V] {
+	forward := make(map[K]V)
+	inverse := make(map[V]K)
+	for k, v := range b.forward {
+		if ov, ok := other.forward[k]; !ok || ov != v {
+			forward[k] = v
+			inverse[v] = k
+		}
+	}
+	return &ImmutableBiMap[K, V]{forward: forward, inverse: inverse}
+}
+
+// Thaw returns a mutable deep copy of the ImmutableBiMap. Changes to the copy do not affect the ImmutableBiMap.
+func (b *ImmutableBiMap[K, V]) Thaw() *BiMap[K, V] {
+	return &BiMap[K, V]{forward: maps.Clone(b.forward), inverse: maps.Clone(b.inverse)}
+}
+
+// Equal reports whether the ImmutableBiMap and other hold the same key-value pairs.
+func (b *ImmutableBiMap[K, V]) Equal(other *ImmutableBiMap[K, V]) bool {
+	return maps.Equal(b.forward, other.forward)
+}
+
+// Inverse returns a view of the ImmutableBiMap with the roles of keys and values swapped, so GetByKey on the result
+// looks up by this map's values. The view shares storage with this map, which is safe because neither can change.
+func (b *ImmutableBiMap[K, V]) Inverse() *ImmutableBiMap[V, K] {
+	return &ImmutableBiMap[V, K]{forward: b.inverse, inverse: b.forward}
+}
+
+// All returns an iterator over the ImmutableBiMap's key-value pairs, in no particular order.
+func (b *ImmutableBiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range b.forward {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Range calls fn for each key-value pair in the ImmutableBiMap, in no particular order, stopping early if fn returns false.
+func (b *ImmutableBiMap[K, V]) Range(fn func(k K, v V) bool) {
+	for k, v := range b.forward {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Keys returns an iterator over the ImmutableBiMap's keys, in no particular order.
+func (b *ImmutableBiMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range b.forward {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the ImmutableBiMap's values, in no particular order.
+func (b *ImmutableBiMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range b.inverse {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ForEach calls fn for every key-value pair in the ImmutableBiMap, in no particular order.
+func (b *ImmutableBiMap[K, V]) ForEach(fn func(k K, v V)) {
+	for k, v := range b.forward {
+		fn(k, v)
+	}
+}
@@ -1,6 +1,8 @@
 package bimap
 
 import (
+	"maps"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -16,6 +18,24 @@ func TestImmutableBiMap_NewFromMap(t *testing.T) {
 	assert.False(t, m.ExistsByValue(99))
 }
 
+func TestImmutableBiMap_NewFromSeq(t *testing.T) {
+	pairs := func(yield func(string, int) bool) {
+		for i, k := range []string{"a", "b", "c"} {
+			if !yield(k, i) {
+				return
+			}
+		}
+	}
+
+	m, err := NewImmutableBiMapFromSeq(pairs, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 0, "b": 1, "c": 2}, m.GetForwardMap())
+	assert.Equal(t, map[int]string{0: "a", 1: "b", 2: "c"}, m.GetInverseMap())
+
+	_, err = NewImmutableBiMapFromSeq(maps.All(map[string]int{"a": 1, "b": 1}), 2)
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+}
+
 func TestImmutableBiMap_GetByKey(t *testing.T) {
 	m := NewImmutableBiMapFromMap(map[string]int{"hello": 42})
 
@@ -67,3 +87,137 @@ func TestImmutableBiMap_MapCopies(t *testing.T) {
 	inv[3] = "c"
 	assert.Equal(t, 2, m.Size(), "ImmutableBiMap should be unaffected by mutations to returned map copies")
 }
+
+func TestImmutableBiMap_WithAll(t *testing.T) {
+	m := NewImmutableBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	derived, err := m.WithAll(map[string]int{"b": 20, "c": 3})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 1, "b": 20, "c": 3}, derived.GetForwardMap())
+	assert.False(t, derived.ExistsByValue(2), "Replaced value should be gone")
+	assert.Equal(t, 2, m.Size(), "Original should be unaffected")
+
+	swapped, err := m.WithAll(map[string]int{"a": 2, "b": 1})
+	assert.NoError(t, err, "Values freed by the same batch should be reusable")
+	assert.Equal(t, map[int]string{1: "b", 2: "a"}, swapped.GetInverseMap())
+
+	_, err = m.WithAll(map[string]int{"c": 1})
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+}
+
+func TestImmutableBiMap_WithoutKeys(t *testing.T) {
+	m := NewImmutableBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	derived := m.WithoutKeys("a", "c", "missing")
+	assert.Equal(t, map[string]int{"b": 2}, derived.GetForwardMap())
+	assert.Equal(t, map[int]string{2: "b"}, derived.GetInverseMap())
+	assert.Equal(t, 3, m.Size(), "Original should be unaffected")
+}
+
+func TestImmutableBiMap_Union(t *testing.T) {
+	a := NewImmutableBiMapFromMap(map[string]int{"a": 1, "b": 2})
+	b := NewImmutableBiMapFromMap(map[string]int{"b": 2, "c": 3})
+
+	union, err := a.Union(b)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3}, union.GetForwardMap())
+
+	_, err = a.Union(NewImmutableBiMapFromMap(map[string]int{"a": 9}))
+	assert.ErrorIs(t, err, ErrDuplicateKey)
+
+	_, err = a.Union(NewImmutableBiMapFromMap(map[string]int{"z": 1}))
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+}
+
+func TestImmutableBiMap_Intersect(t *testing.T) {
+	a := NewImmutableBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	b := NewImmutableBiMapFromMap(map[string]int{"b": 2, "c": 30})
+
+	assert.Equal(t, map[string]int{"b": 2}, a.Intersect(b).GetForwardMap())
+}
+
+func TestImmutableBiMap_Difference(t *testing.T) {
+	a := NewImmutableBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	b := NewImmutableBiMapFromMap(map[string]int{"b": 2, "c": 30})
+
+	diff := a.Difference(b)
+	assert.Equal(t, map[string]int{"a": 1, "c": 3}, diff.GetForwardMap())
+	assert.Equal(t, map[int]string{1: "a", 3: "c"}, diff.GetInverseMap())
+}
+
+func TestImmutableBiMap_All(t *testing.T) {
+	m := NewImmutableBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3}, maps.Collect(m.All()))
+
+	count := 0
+	for range m.All() {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count, "Iteration should stop on break")
+}
+
+func TestImmutableBiMap_Range(t *testing.T) {
+	m := NewImmutableBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	seen := make(map[string]int)
+	m.Range(func(k string, v int) bool {
+		seen[k] = v
+		return true
+	})
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3}, seen)
+
+	count := 0
+	m.Range(func(string, int) bool {
+		count++
+		return false
+	})
+	assert.Equal(t, 1, count, "Iteration should stop when fn returns false")
+}
+
+func TestImmutableBiMap_KeysAndValues(t *testing.T) {
+	m := NewImmutableBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	assert.Equal(t, []string{"a", "b"}, slices.Sorted(m.Keys()))
+	assert.Equal(t, []int{1, 2}, slices.Sorted(m.Values()))
+}
+
+func TestImmutableBiMap_ForEach(t *testing.T) {
+	m := NewImmutableBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	seen := make(map[string]int)
+	m.ForEach(func(k string, v int) { seen[k] = v })
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, seen)
+}
+
+func TestImmutableBiMap_Inverse(t *testing.T) {
+	m := NewImmutableBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	inverse := m.Inverse()
+	k, ok := inverse.GetByKey(2)
+	assert.True(t, ok)
+	assert.Equal(t, "b", k)
+	assert.Equal(t, map[int]string{1: "a", 2: "b"}, inverse.GetForwardMap())
+	assert.Equal(t, m.GetForwardMap(), inverse.Inverse().GetForwardMap())
+}
+
+func TestImmutableBiMap_Equal(t *testing.T) {
+	m := NewImmutableBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	assert.True(t, m.Equal(NewImmutableBiMapFromMap(map[string]int{"a": 1, "b": 2})))
+	assert.False(t, m.Equal(NewImmutableBiMapFromMap(map[string]int{"a": 2, "b": 1})))
+	assert.False(t, m.Equal(m.WithoutKeys("a")))
+}
+
+func TestImmutableBiMap_Thaw(t *testing.T) {
+	m := NewImmutableBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	thawed := m.Thaw()
+	assert.True(t, thawed.EqualImmutable(m))
+
+	thawed.Insert("c", 3)
+	thawed.DeleteByValue(1)
+	assert.Equal(t, map[string]int{"b": 2, "c": 3}, thawed.SnapshotForward())
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, m.GetForwardMap(), "ImmutableBiMap should be unaffected")
+}
@@ -0,0 +1,29 @@
+package bimap
+
+import "iter"
+
+// BiMapper is the set of lookups shared by BiMap and ImmutableBiMap, for APIs that accept either regardless of mutability.
+type BiMapper[K comparable, V comparable] interface {
+	GetByKey(k K) (V, bool)
+	GetByValue(v V) (K, bool)
+	ExistsByKey(k K) bool
+	ExistsByValue(v V) bool
+	Size() int
+	Range(fn func(k K, v V) bool)
+}
+
+// ReadOnlyBiMap is the full read-only API of BiMap and ImmutableBiMap. Accept it where a dependency only reads the
+// map, so tests can substitute a stub.
+type ReadOnlyBiMap[K comparable, V comparable] interface {
+	BiMapper[K, V]
+	GetByKeyWithFallback(k K, fallback V) V
+	GetByValueWithFallback(v V, fallback K) K
+	All() iter.Seq2[K, V]
+	Keys() iter.Seq[K]
+	Values() iter.Seq[V]
+}
+
+var (
+	_ ReadOnlyBiMap[int, int] = (*BiMap[int, int])(nil)
+	_ ReadOnlyBiMap[int, int] = (*ImmutableBiMap[int, int])(nil)
+)
@@ -0,0 +1,33 @@
+package bimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func countPositive(m BiMapper[string, int]) int {
+	count := 0
+	m.Range(func(_ string, v int) bool {
+		if v > 0 {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+func TestBiMapper(t *testing.T) {
+	pairs := map[string]int{"a": 1, "b": -2, "c": 3}
+
+	assert.Equal(t, 2, countPositive(NewBiMapFromMap(pairs)))
+	assert.Equal(t, 2, countPositive(NewImmutableBiMapFromMap(pairs)))
+}
+
+func TestReadOnlyBiMap(t *testing.T) {
+	var m ReadOnlyBiMap[string, int] = NewImmutableBiMapFromMap(map[string]int{"a": 1})
+
+	assert.Equal(t, 1, m.GetByKeyWithFallback("a", 0))
+	assert.Equal(t, "none", m.GetByValueWithFallback(2, "none"))
+	assert.True(t, m.ExistsByValue(1))
+}
@@ -0,0 +1,35 @@
+package bimap
+
+import "sync"
+
+// Interner is a registry of strings shared between bimaps so that identical keys and values
+// inserted into many bimaps are stored once. Attach it with WithInterner. Safe for concurrent use.
+//
+// Interned strings are never released; it is meant for bounded vocabularies such as codes and labels.
+type Interner struct {
+	mu      sync.Mutex
+	strings map[string]string
+}
+
+// NewInterner returns an empty Interner.
+func NewInterner() *Interner {
+	return &Interner{strings: make(map[string]string)}
+}
+
+// Intern returns the canonical copy of s, registering s if it has not been seen before.
+func (in *Interner) Intern(s string) string {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	if canonical, ok := in.strings[s]; ok {
+		return canonical
+	}
+	in.strings[s] = s
+	return s
+}
+
+// Size returns the number of distinct strings in the Interner.
+func (in *Interner) Size() int {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	return len(in.strings)
+}
@@ -0,0 +1,34 @@
+package bimap
+
+import (
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterner_Intern(t *testing.T) {
+	in := NewInterner()
+	a := in.Intern(strings.Repeat("x", 8))
+	b := in.Intern(strings.Repeat("x", 8))
+
+	assert.Same(t, unsafe.StringData(a), unsafe.StringData(b), "Identical strings should share storage")
+	assert.Equal(t, 1, in.Size())
+}
+
+func TestWithInterner(t *testing.T) {
+	in := NewInterner()
+	first := NewBiMap[string, string](WithInterner(in))
+	second := NewBiMap[string, string](WithInterner(in))
+	first.Insert(strings.Repeat("k", 4), strings.Repeat("v", 4))
+	second.Insert(strings.Repeat("k", 4), strings.Repeat("v", 4))
+
+	assert.Equal(t, 2, in.Size(), "Keys and values should be registered once")
+	k1, _ := first.GetByValue("vvvv")
+	k2, _ := second.GetByValue("vvvv")
+	assert.Same(t, unsafe.StringData(k1), unsafe.StringData(k2), "Keys should share storage across bimaps")
+
+	assert.False(t, first.ExistsByKey("missing"))
+	assert.Equal(t, 2, in.Size(), "Lookups should not register strings")
+}
@@ -0,0 +1,122 @@
+package bimap
+
+import (
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"reflect"
+)
+
+// Format identifies an on-disk encoding of a bimap.
+type Format int
+
+const (
+	// FormatJSON is a JSON object of key-value pairs, as written by MarshalJSON.
+	FormatJSON Format = iota
+	// FormatCSV is a two-column CSV file of keys and values, without a header row. Cells are decoded with
+	// UnmarshalText for types implementing encoding.TextUnmarshaler, taken verbatim for strings and decoded as JSON
+	// otherwise.
+	FormatCSV
+	// FormatSnapshot is the binary snapshot format written by MarshalBinary.
+	FormatSnapshot
+)
+
+// String returns the name of the format.
+func (f Format) String() string {
+	switch f {
+	case FormatJSON:
+		return "json"
+	case FormatCSV:
+		return "csv"
+	case FormatSnapshot:
+		return "snapshot"
+	}
+	return fmt.Sprintf("Format(%d)", int(f))
+}
+
+// LoadImmutableFromFS reads the file at path from fsys in the given format and builds an ImmutableBiMap from it.
+// It is intended for tables embedded with go:embed; errors name the file and, for CSV, the offending line.
+func LoadImmutableFromFS[K comparable, V comparable](fsys fs.FS, path string, format Format) (*ImmutableBiMap[K, V], error) {
+	f, err := fsys.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var forward map[K]V
+	var inverse map[V]K
+	switch format {
+	case FormatJSON:
+		err = json.NewDecoder(f).Decode(&forward)
+		if err == nil {
+			inverse, err = invert(forward)
+		}
+	case FormatCSV:
+		forward, inverse, err = readCSVPairs[K, V](f)
+	case FormatSnapshot:
+		var data []byte
+		data, err = io.ReadAll(f)
+		if err == nil {
+			forward, inverse, err = decodeSnapshot[K, V](data)
+		}
+	default:
+		err = fmt.Errorf("unsupported format %v", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("bimap: loading %s as %v: %w", path, format, err)
+	}
+	return &ImmutableBiMap[K, V]{forward: forward, inverse: inverse}, nil
+}
+
+// readCSVPairs reads key,value records, failing on malformed cells and duplicate keys or values.
+func readCSVPairs[K comparable, V comparable](r io.Reader) (map[K]V, map[V]K, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+	forward := make(map[K]V)
+	inverse := make(map[V]K)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return forward, inverse, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		k, err := parseCell[K](record[0])
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: key: %w", line, err)
+		}
+		v, err := parseCell[V](record[1])
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: value: %w", line, err)
+		}
+		if _, ok := forward[k]; ok {
+			return nil, nil, fmt.Errorf("line %d: %w: %v", line, ErrDuplicateKey, k)
+		}
+		if _, ok := inverse[v]; ok {
+			return nil, nil, fmt.Errorf("line %d: %w: %v", line, ErrDuplicateValue, v)
+		}
+		forward[k] = v
+		inverse[v] = k
+	}
+}
+
+// parseCell converts a text cell to T. Types implementing encoding.TextUnmarshaler decode it with UnmarshalText,
+// string types take the cell verbatim and other types are decoded as JSON.
+func parseCell[T any](cell string) (T, error) {
+	var t T
+	if u, ok := any(&t).(encoding.TextUnmarshaler); ok {
+		err := u.UnmarshalText([]byte(cell))
+		return t, err
+	}
+	if reflect.TypeOf(&t).Elem().Kind() == reflect.String {
+		return fromString[T](cell), nil
+	}
+	err := json.Unmarshal([]byte(cell), &t)
+	return t, err
+}
@@ -0,0 +1,55 @@
+package bimap
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadImmutableFromFS(t *testing.T) {
+	snapshot, err := NewImmutableBiMapFromMap(map[string]int{"a": 1, "b": 2}).MarshalBinary()
+	assert.NoError(t, err)
+	fsys := fstest.MapFS{
+		"codes.json": {Data: []byte(`{"a": 1, "b": 2}`)},
+		"codes.csv":  {Data: []byte("a,1\nb,2\n")},
+		"codes.bin":  {Data: snapshot},
+	}
+
+	for path, format := range map[string]Format{"codes.json": FormatJSON, "codes.csv": FormatCSV, "codes.bin": FormatSnapshot} {
+		m, err := LoadImmutableFromFS[string, int](fsys, path, format)
+		assert.NoError(t, err, path)
+		assert.Equal(t, map[string]int{"a": 1, "b": 2}, m.GetForwardMap(), path)
+		assert.Equal(t, map[int]string{1: "a", 2: "b"}, m.GetInverseMap(), path)
+	}
+}
+
+func TestLoadImmutableFromFS_Errors(t *testing.T) {
+	fsys := fstest.MapFS{
+		"dup.json":   {Data: []byte(`{"a": 1, "b": 1}`)},
+		"dup.csv":    {Data: []byte("a,1\nb,2\nc,2\n")},
+		"bad.csv":    {Data: []byte("a,1\nb,two\n")},
+		"short.csv":  {Data: []byte("a\n")},
+		"codes.json": {Data: []byte(`{}`)},
+	}
+
+	_, err := LoadImmutableFromFS[string, int](fsys, "dup.json", FormatJSON)
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+
+	_, err = LoadImmutableFromFS[string, int](fsys, "dup.csv", FormatCSV)
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+	assert.Contains(t, err.Error(), "dup.csv")
+	assert.Contains(t, err.Error(), "line 3")
+
+	_, err = LoadImmutableFromFS[string, int](fsys, "bad.csv", FormatCSV)
+	assert.ErrorContains(t, err, "line 2: value")
+
+	_, err = LoadImmutableFromFS[string, int](fsys, "short.csv", FormatCSV)
+	assert.Error(t, err)
+
+	_, err = LoadImmutableFromFS[string, int](fsys, "missing.json", FormatJSON)
+	assert.Error(t, err)
+
+	_, err = LoadImmutableFromFS[string, int](fsys, "codes.json", Format(99))
+	assert.ErrorContains(t, err, "unsupported format")
+}
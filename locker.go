@@ -0,0 +1,49 @@
+package bimap
+
+import "sync"
+
+// RWLocker is a reader/writer lock, such as *sync.RWMutex, that a BiMap can use in place of its own mutex.
+// Lockers that also have TryLock and TryRLock methods support the BiMap's TryLock, TryRLock, LockCtx and RLockCtx.
+type RWLocker interface {
+	sync.Locker
+	RLock()
+	RUnlock()
+}
+
+// NewBiMapWithLocker returns an empty, mutable BiMap that locks with l instead of its own mutex, for example a
+// tracing or deadlock-detecting lock. A nil l selects the default mutex. Clones and maps derived from the BiMap,
+// such as Filter results, use their own mutex.
+func NewBiMapWithLocker[K comparable, V comparable](l RWLocker, opts ...Option) *BiMap[K, V] {
+	b := NewBiMap[K, V](opts...)
+	b.locker = l
+	return b
+}
+
+// ExclusiveLocker adapts a sync.Locker into an RWLocker whose read locks are exclusive, so any sync.Locker can be
+// passed to NewBiMapWithLocker. The result has TryLock and TryRLock methods only if l has a TryLock method.
+func ExclusiveLocker(l sync.Locker) RWLocker {
+	if t, ok := l.(tryLocker); ok {
+		return exclusiveTryLocker{exclusiveLocker{l}, t}
+	}
+	return exclusiveLocker{l}
+}
+
+type tryLocker interface {
+	sync.Locker
+	TryLock() bool
+}
+
+type exclusiveLocker struct {
+	sync.Locker
+}
+
+func (l exclusiveLocker) RLock()   { l.Lock() }
+func (l exclusiveLocker) RUnlock() { l.Unlock() }
+
+type exclusiveTryLocker struct {
+	exclusiveLocker
+	t tryLocker
+}
+
+func (l exclusiveTryLocker) TryLock() bool  { return l.t.TryLock() }
+func (l exclusiveTryLocker) TryRLock() bool { return l.t.TryLock() }
@@ -0,0 +1,110 @@
+package bimap
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type countingLocker struct {
+	sync.RWMutex
+	locks, rlocks int
+}
+
+func (l *countingLocker) Lock() {
+	l.RWMutex.Lock()
+	l.locks++
+}
+
+func (l *countingLocker) RLock() {
+	l.RWMutex.RLock()
+	l.rlocks++
+}
+
+func TestNewBiMapWithLocker(t *testing.T) {
+	l := &countingLocker{}
+	actual := NewBiMapWithLocker[string, int](l)
+	actual.Insert("a", 1)
+	assert.Equal(t, 1, l.locks, "Insert should take the supplied lock for writing")
+
+	v, ok := actual.GetByKey("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, 1, l.rlocks, "GetByKey should take the supplied lock for reading")
+
+	clone := actual.Clone()
+	clone.Insert("b", 2)
+	assert.Equal(t, 1, l.locks, "Clones should not share the supplied lock")
+}
+
+func TestNewBiMapWithLockerNil(t *testing.T) {
+	actual := NewBiMapWithLocker[string, int](nil)
+	actual.Insert("a", 1)
+	assert.True(t, actual.ExistsByKey("a"))
+	assert.True(t, actual.TryLock(), "A nil locker should select the default mutex")
+	actual.Unlock()
+}
+
+func TestNewBiMapWithLockerTryLock(t *testing.T) {
+	l := &sync.RWMutex{}
+	actual := NewBiMapWithLocker[string, int](l)
+	l.Lock()
+	assert.False(t, actual.TryLock(), "TryLock should use the locker's TryLock")
+	assert.False(t, actual.TryRLock())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, actual.LockCtx(ctx), context.DeadlineExceeded)
+	l.Unlock()
+
+	assert.True(t, actual.TryLock())
+	actual.Unlock()
+}
+
+func TestExclusiveLocker(t *testing.T) {
+	m := &sync.Mutex{}
+	actual := NewBiMapWithLocker[string, int](ExclusiveLocker(m))
+	actual.Insert("a", 1)
+	assert.True(t, actual.ExistsByKey("a"))
+
+	actual.RLock()
+	assert.False(t, m.TryLock(), "Read locks should hold the mutex exclusively")
+	assert.False(t, actual.TryRLock())
+	actual.RUnlock()
+
+	assert.NoError(t, actual.RLockCtx(context.Background()))
+	actual.RUnlock()
+}
+
+type plainLocker struct {
+	m sync.Mutex
+}
+
+func (l *plainLocker) Lock()    { l.m.Lock() }
+func (l *plainLocker) Unlock()  { l.m.Unlock() }
+func (l *plainLocker) RLock()   { l.m.Lock() }
+func (l *plainLocker) RUnlock() { l.m.Unlock() }
+
+func TestExclusiveLockerWithoutTryLock(t *testing.T) {
+	actual := NewBiMapWithLocker[string, int](ExclusiveLocker(&plainLocker{}))
+	actual.Insert("a", 1)
+	assert.False(t, actual.TryLock(), "TryLock should fail when the wrapped locker cannot try")
+	assert.False(t, actual.TryRLock())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.NoError(t, actual.LockCtx(ctx), "LockCtx should fall back to blocking on a free lock")
+	actual.Unlock()
+	assert.NoError(t, actual.RLockCtx(ctx))
+	actual.RUnlock()
+}
+
+func TestNewBiMapWithLockerWithoutTryLock(t *testing.T) {
+	actual := NewBiMapWithLocker[string, int](&plainLocker{})
+	assert.False(t, actual.TryLock(), "TryLock should fail when the locker cannot try")
+	assert.NoError(t, actual.LockCtx(context.Background()), "LockCtx should fall back to blocking")
+	actual.Unlock()
+}
@@ -0,0 +1,42 @@
+package bimap
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// FindKeysMatching returns the keys matching re, sorted. Keys that are not strings are matched against their fmt.Sprint form.
+func (b *BiMap[K, V]) FindKeysMatching(re *regexp.Regexp) []K {
+	stringKeys := isStringType[K]()
+	b.rlock()
+	var keys []K
+	var texts []string
+	for k := range b.forward {
+		var text string
+		if stringKeys {
+			text = stringOf(k)
+		} else {
+			text = fmt.Sprint(k)
+		}
+		if re.MatchString(text) {
+			keys = append(keys, k)
+			texts = append(texts, text)
+		}
+	}
+	b.runlock()
+	sort.Sort(keysByText[K]{keys, texts})
+	return keys
+}
+
+type keysByText[K any] struct {
+	keys  []K
+	texts []string
+}
+
+func (s keysByText[K]) Len() int           { return len(s.keys) }
+func (s keysByText[K]) Less(i, j int) bool { return s.texts[i] < s.texts[j] }
+func (s keysByText[K]) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.texts[i], s.texts[j] = s.texts[j], s.texts[i]
+}
@@ -0,0 +1,38 @@
+package bimap
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap_FindKeysMatching(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{
+		"eu/api/1":  1,
+		"eu/web/2":  2,
+		"us/api/3":  3,
+		"eu/api/10": 4,
+	})
+
+	assert.Equal(t, []string{"eu/api/1", "eu/api/10"}, actual.FindKeysMatching(regexp.MustCompile(`^eu/api/`)))
+	assert.Empty(t, actual.FindKeysMatching(regexp.MustCompile(`^ap/`)))
+}
+
+func TestBiMap_FindKeysMatching_NonStringKeys(t *testing.T) {
+	actual := NewBiMapFromMap(map[int]string{12: "a", 21: "b", 3: "c"})
+
+	assert.Equal(t, []int{12, 21}, actual.FindKeysMatching(regexp.MustCompile(`^\d\d$`)))
+}
+
+func TestWithKeyPattern(t *testing.T) {
+	actual := NewBiMap[string, int](WithKeyPattern(regexp.MustCompile(`^[a-z]+/[a-z]+/\d+$`)))
+
+	assert.NoError(t, actual.TryInsert("eu/api/1", 1))
+	assert.ErrorIs(t, actual.TryInsert("eu-api-1", 2), ErrInvalidKey)
+	assert.Equal(t, 1, actual.Size())
+
+	assert.Panics(t, func() {
+		NewBiMap[int, int](WithKeyPattern(regexp.MustCompile(`.`)))
+	}, "It should panic for non-string keys")
+}
@@ -0,0 +1,45 @@
+package bimap
+
+import (
+	"math/bits"
+	"unsafe"
+)
+
+// ApproxBytes estimates the memory held by the BiMap: its struct, the hash tables of both directions and of the
+// collation index, and, for string keys and values, their contents, counted once since both directions share them.
+// Memory referenced through other pointers is not counted. The estimate models Go's map layout, so treat it as a
+// guide for capacity planning rather than an exact figure. For string types it costs O(n).
+func (b *BiMap[K, V]) ApproxBytes() uintptr {
+	b.rlock()
+	defer b.runlock()
+	var zk K
+	var zv V
+	size := unsafe.Sizeof(*b)
+	pair := unsafe.Sizeof(zk) + unsafe.Sizeof(zv)
+	size += mapBytes(len(b.forward), pair) + mapBytes(len(b.inverse), pair)
+	if b.folded != nil {
+		size += mapBytes(len(b.folded), unsafe.Sizeof("")+unsafe.Sizeof(zk))
+	}
+	if isStringType[K]() {
+		for k := range b.forward {
+			size += uintptr(len(stringOf(k)))
+		}
+	}
+	if isStringType[V]() {
+		for v := range b.inverse {
+			size += uintptr(len(stringOf(v)))
+		}
+	}
+	return size
+}
+
+// mapBytes estimates the size of a map holding n entries of slotSize bytes each. Maps keep slots in groups of eight,
+// each slot with a control byte, grow to a power-of-two number of slots and are at most 7/8 full.
+func mapBytes(n int, slotSize uintptr) uintptr {
+	if n == 0 {
+		return 0
+	}
+	slots := uint(max(8, (n*8+6)/7))
+	slots = 1 << bits.Len(slots-1)
+	return uintptr(slots) * (slotSize + 1)
+}
@@ -0,0 +1,46 @@
+package bimap
+
+import (
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap_ApproxBytes(t *testing.T) {
+	empty := NewBiMap[int, int]()
+	small := NewBiMapFromMap(map[int]int{1: 1})
+	assert.Greater(t, small.ApproxBytes(), empty.ApproxBytes())
+
+	ints := NewBiMap[int64, int64]()
+	strs := NewBiMap[string, string]()
+	for i := range 1000 {
+		ints.Insert(int64(i), int64(-i))
+		strs.Insert(strconv.Itoa(i)+"-key-padding", strconv.Itoa(i)+"-value-padding")
+	}
+	assert.Greater(t, strs.ApproxBytes(), ints.ApproxBytes(), "String contents should be counted")
+}
+
+func TestBiMap_ApproxBytes_Accuracy(t *testing.T) {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	b := NewBiMap[int64, int64]()
+	for i := range int64(100_000) {
+		b.Insert(i, -i)
+	}
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+
+	measured := float64(after.HeapAlloc - before.HeapAlloc)
+	estimate := float64(b.ApproxBytes())
+	assert.InDelta(t, 1, estimate/measured, 0.25, "Estimate %v should be within 25%% of the measured %v", estimate, measured)
+	runtime.KeepAlive(b)
+}
+
+func TestMapBytes(t *testing.T) {
+	assert.Equal(t, uintptr(0), mapBytes(0, 16))
+	assert.Equal(t, uintptr(8*17), mapBytes(1, 16), "Maps should hold at least one group")
+	assert.Equal(t, uintptr(16*17), mapBytes(8, 16), "Maps should be at most 7/8 full")
+}
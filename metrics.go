@@ -0,0 +1,43 @@
+package bimap
+
+import "expvar"
+
+// Collector receives usage metrics from a BiMap built with WithMetrics. Its methods are called while the BiMap's
+// lock is held, so they must be fast, must not call back into the BiMap, and must be safe for concurrent use.
+type Collector interface {
+	// Inserted is called once for every pair stored, including replacements of an existing key's value.
+	Inserted()
+	// Deleted is called once for every pair removed, including pairs removed by Clear.
+	Deleted()
+	// Hit is called when GetByKey or GetByValue finds an entry.
+	Hit()
+	// Missed is called when GetByKey or GetByValue finds no entry.
+	Missed()
+	// SizeChanged is called with the number of entries after every mutation.
+	SizeChanged(size int)
+}
+
+// ExpvarCollector is a Collector that publishes its counters as an expvar.Map with the keys
+// "inserts", "deletes", "hits", "misses" and "size".
+type ExpvarCollector struct {
+	inserts, deletes, hits, misses, size expvar.Int
+}
+
+// NewExpvarCollector returns an ExpvarCollector published under name. Like expvar.Publish, it panics if name is
+// already in use.
+func NewExpvarCollector(name string) *ExpvarCollector {
+	c := &ExpvarCollector{}
+	m := expvar.NewMap(name)
+	m.Set("inserts", &c.inserts)
+	m.Set("deletes", &c.deletes)
+	m.Set("hits", &c.hits)
+	m.Set("misses", &c.misses)
+	m.Set("size", &c.size)
+	return c
+}
+
+func (c *ExpvarCollector) Inserted()            { c.inserts.Add(1) }
+func (c *ExpvarCollector) Deleted()             { c.deletes.Add(1) }
+func (c *ExpvarCollector) Hit()                 { c.hits.Add(1) }
+func (c *ExpvarCollector) Missed()              { c.misses.Add(1) }
+func (c *ExpvarCollector) SizeChanged(size int) { c.size.Set(int64(size)) }
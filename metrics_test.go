@@ -0,0 +1,132 @@
+package bimap
+
+import (
+	"expvar"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type countingCollector struct {
+	mu                             sync.Mutex
+	inserts, deletes, hits, misses int
+	size                           int
+}
+
+func (c *countingCollector) Inserted()            { c.mu.Lock(); c.inserts++; c.mu.Unlock() }
+func (c *countingCollector) Deleted()             { c.mu.Lock(); c.deletes++; c.mu.Unlock() }
+func (c *countingCollector) Hit()                 { c.mu.Lock(); c.hits++; c.mu.Unlock() }
+func (c *countingCollector) Missed()              { c.mu.Lock(); c.misses++; c.mu.Unlock() }
+func (c *countingCollector) SizeChanged(size int) { c.mu.Lock(); c.size = size; c.mu.Unlock() }
+
+func TestWithMetrics(t *testing.T) {
+	c := &countingCollector{}
+	actual := NewBiMap[string, int](WithMetrics(c))
+
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+	actual.Insert("a", 3)
+	assert.Equal(t, 3, c.inserts, "Replacements should count as inserts")
+	assert.Equal(t, 2, c.size)
+
+	actual.GetByKey("a")
+	actual.GetByValue(2)
+	actual.GetByKey("missing")
+	actual.GetByValueWithFallback(42, "none")
+	assert.Equal(t, 2, c.hits)
+	assert.Equal(t, 2, c.misses)
+
+	actual.DeleteByKey("a")
+	assert.Equal(t, 1, c.deletes)
+	assert.Equal(t, 1, c.size)
+
+	actual.Clear()
+	assert.Equal(t, 2, c.deletes, "Clear should report each removed pair")
+	assert.Equal(t, 0, c.size)
+}
+
+func TestWithMetrics_RemapKeys(t *testing.T) {
+	c := &countingCollector{}
+	actual := NewBiMap[string, int](WithMetrics(c))
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+
+	_, err := actual.RemapKeys(func(k string) string {
+		if k == "a" {
+			return "z"
+		}
+		return k
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, c.inserts, "Each rewritten key should count as an insert")
+	assert.Equal(t, 1, c.deletes, "Each rewritten key should count as a delete")
+	assert.Equal(t, 2, c.size)
+}
+
+func TestWithMetrics_RemapValues(t *testing.T) {
+	c := &countingCollector{}
+	actual := NewBiMap[string, int](WithMetrics(c))
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+
+	_, err := actual.RemapValues(func(v int) int { return v * 10 })
+	assert.NoError(t, err)
+	assert.Equal(t, 4, c.inserts, "Each rewritten value should count as an insert")
+	assert.Zero(t, c.deletes)
+}
+
+func TestWithMetrics_SwapValues(t *testing.T) {
+	c := &countingCollector{}
+	actual := NewBiMap[string, int](WithMetrics(c))
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+
+	assert.NoError(t, actual.SwapValues("a", "b"))
+	assert.Equal(t, 4, c.inserts, "Both swapped keys should count as inserts")
+	assert.Zero(t, c.deletes)
+}
+
+func TestWithMetrics_Rename(t *testing.T) {
+	c := &countingCollector{}
+	actual := NewBiMap[string, int](WithMetrics(c))
+	actual.Insert("a", 1)
+
+	assert.NoError(t, actual.RenameKey("a", "b"))
+	assert.Equal(t, 2, c.inserts)
+	assert.Equal(t, 1, c.deletes, "Renaming a key should count as a delete and an insert")
+
+	assert.NoError(t, actual.RenameValue(1, 2))
+	assert.Equal(t, 3, c.inserts, "Renaming a value should count as an insert")
+	assert.Equal(t, 1, c.deletes)
+}
+
+func TestWithMetrics_NotCarriedByClone(t *testing.T) {
+	c := &countingCollector{}
+	actual := NewBiMap[string, int](WithMetrics(c))
+	actual.Insert("a", 1)
+
+	clone := actual.Clone()
+	clone.Insert("b", 2)
+	clone.GetByKey("b")
+	assert.Equal(t, 1, c.inserts)
+	assert.Equal(t, 0, c.hits)
+	assert.Equal(t, 1, c.size)
+}
+
+func TestNewExpvarCollector(t *testing.T) {
+	actual := NewBiMap[string, int](WithMetrics(NewExpvarCollector("bimap_test_metrics")))
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+	actual.GetByKey("a")
+	actual.GetByKey("c")
+	actual.DeleteByValue(2)
+
+	m := expvar.Get("bimap_test_metrics").(*expvar.Map)
+	assert.Equal(t, "2", m.Get("inserts").String())
+	assert.Equal(t, "1", m.Get("deletes").String())
+	assert.Equal(t, "1", m.Get("hits").String())
+	assert.Equal(t, "1", m.Get("misses").String())
+	assert.Equal(t, "1", m.Get("size").String())
+	assert.Panics(t, func() { NewExpvarCollector("bimap_test_metrics") }, "Duplicate names should panic")
+}
@@ -0,0 +1,106 @@
+package bimap
+
+import (
+	"maps"
+	"slices"
+	"sync"
+)
+
+// MultiBiMap is a thread safe one-to-many bidirectional map: a key maps to a set of values, and each value maps back
+// to exactly one key, as with a user's aliases.
+type MultiBiMap[K comparable, V comparable] struct {
+	s       sync.RWMutex
+	forward map[K]map[V]struct{}
+	inverse map[V]K
+}
+
+// NewMultiBiMap returns an empty MultiBiMap.
+func NewMultiBiMap[K comparable, V comparable]() *MultiBiMap[K, V] {
+	return &MultiBiMap[K, V]{forward: make(map[K]map[V]struct{}), inverse: make(map[V]K)}
+}
+
+// Insert adds v to k's values. If v belonged to another key it is moved, so each value keeps exactly one key.
+func (b *MultiBiMap[K, V]) Insert(k K, v V) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if owner, ok := b.inverse[v]; ok {
+		if owner == k {
+			return
+		}
+		b.unlink(owner, v)
+	}
+	addToSet(b.forward, k, v)
+	b.inverse[v] = k
+}
+
+// GetValuesByKey returns a copy of k's values, in no particular order, or nil if k has none.
+func (b *MultiBiMap[K, V]) GetValuesByKey(k K) []V {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	values, ok := b.forward[k]
+	if !ok {
+		return nil
+	}
+	return slices.Collect(maps.Keys(values))
+}
+
+// GetKeyByValue returns the key v belongs to and whether or not the element was present.
+func (b *MultiBiMap[K, V]) GetKeyByValue(v V) (K, bool) {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	k, ok := b.inverse[v]
+	return k, ok
+}
+
+// ExistsByKey checks whether or not a key has any values in the MultiBiMap.
+func (b *MultiBiMap[K, V]) ExistsByKey(k K) bool {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	_, ok := b.forward[k]
+	return ok
+}
+
+// ExistsByValue checks whether or not a value exists in the MultiBiMap.
+func (b *MultiBiMap[K, V]) ExistsByValue(v V) bool {
+	_, ok := b.GetKeyByValue(v)
+	return ok
+}
+
+// DeleteByKey removes k and all of its values. Returns if the key doesn't exist.
+func (b *MultiBiMap[K, V]) DeleteByKey(k K) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	for v := range b.forward[k] {
+		delete(b.inverse, v)
+	}
+	delete(b.forward, k)
+}
+
+// DeleteByValue removes v from its key, removing the key too if it has no values left. Returns if the value doesn't exist.
+func (b *MultiBiMap[K, V]) DeleteByValue(v V) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if k, ok := b.inverse[v]; ok {
+		b.unlink(k, v)
+	}
+}
+
+// Size returns the number of values, that is, of key-value pairs, in the MultiBiMap.
+func (b *MultiBiMap[K, V]) Size() int {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	return len(b.inverse)
+}
+
+// KeyCount returns the number of keys in the MultiBiMap.
+func (b *MultiBiMap[K, V]) KeyCount() int {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	return len(b.forward)
+}
+
+// unlink removes the pair k, v and drops k once it has no values. The caller must hold the write lock.
+func (b *MultiBiMap[K, V]) unlink(k K, v V) {
+	delete(b.inverse, v)
+	removeFromSet(b.forward, k, v)
+}
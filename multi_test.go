@@ -0,0 +1,37 @@
+package bimap
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiBiMap(t *testing.T) {
+	actual := NewMultiBiMap[string, string]()
+	actual.Insert("ann", "a.smith")
+	actual.Insert("ann", "annie")
+	actual.Insert("bob", "rob")
+
+	assert.Equal(t, []string{"a.smith", "annie"}, slices.Sorted(slices.Values(actual.GetValuesByKey("ann"))))
+	assert.Nil(t, actual.GetValuesByKey("carl"))
+	k, ok := actual.GetKeyByValue("rob")
+	assert.True(t, ok)
+	assert.Equal(t, "bob", k)
+	assert.Equal(t, 3, actual.Size())
+	assert.Equal(t, 2, actual.KeyCount())
+
+	actual.Insert("bob", "annie")
+	k, _ = actual.GetKeyByValue("annie")
+	assert.Equal(t, "bob", k, "Inserting a value owned by another key should move it")
+	assert.Equal(t, []string{"a.smith"}, actual.GetValuesByKey("ann"))
+	assert.Equal(t, 3, actual.Size())
+
+	actual.DeleteByValue("a.smith")
+	assert.False(t, actual.ExistsByKey("ann"), "Keys without values should be removed")
+	actual.DeleteByKey("bob")
+	assert.False(t, actual.ExistsByValue("rob"))
+	assert.False(t, actual.ExistsByValue("annie"))
+	assert.Equal(t, 0, actual.Size())
+	assert.Equal(t, 0, actual.KeyCount())
+}
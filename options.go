@@ -0,0 +1,336 @@
+package bimap
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sync"
+	"time"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Option configures a BiMap at construction time.
+type Option func(*config)
+
+type config struct {
+	collator          func() *collate.Collator
+	stringNormalizers []func(string) string
+	interner          *Interner
+	contention        bool
+	lookupStats       bool
+	softDelete        bool
+	audit             bool
+	maxEntries        int
+	keyValidators     []any
+	valueValidators   []any
+	keyNormalizers    []any
+	valueNormalizers  []any
+	keyPattern        *regexp.Regexp
+	healthTracking    bool
+	noLocking         bool
+	metrics           Collector
+}
+
+// WithCollator makes string keys that collate as equal under the given language's Unicode collation rules
+// resolve to the same entry, e.g. WithCollator(language.French, collate.IgnoreCase, collate.IgnoreDiacritics).
+// The first spelling of a key that is inserted is the one that is stored and returned by GetByValue.
+// It panics at construction time if the key type is not a string type. For a SortedBiMap ordered and matched by
+// collation, see NewCollatedSortedBiMap.
+func WithCollator(tag language.Tag, opts ...collate.Option) Option {
+	return func(c *config) {
+		c.collator = func() *collate.Collator { return collate.New(tag, opts...) }
+	}
+}
+
+// WithUnicodeNormalization converts string keys and values to the given normal form on insert and lookup,
+// so visually identical strings in different normal forms map to the same entry.
+// Non-string keys or values are left untouched; it panics at construction time if neither side is a string type.
+func WithUnicodeNormalization(form norm.Form) Option {
+	return func(c *config) {
+		c.stringNormalizers = append(c.stringNormalizers, form.String)
+	}
+}
+
+// WithInterner stores string keys and values through the shared Interner on insert,
+// so identical strings held by several bimaps attached to the same Interner are stored once.
+// Non-string keys or values are left untouched.
+func WithInterner(in *Interner) Option {
+	return func(c *config) {
+		c.interner = in
+	}
+}
+
+// WithContentionProfiling records how long each operation waits to acquire the BiMap's mutex.
+// The totals are reported by Stats.
+func WithContentionProfiling() Option {
+	return func(c *config) {
+		c.contention = true
+	}
+}
+
+// WithLookupStats counts lookup hits and misses by key and by value. The counts are reported by Stats.
+func WithLookupStats() Option {
+	return func(c *config) {
+		c.lookupStats = true
+	}
+}
+
+// WithSoftDelete makes DeleteByKey and DeleteByValue keep a tombstone of the removed pair,
+// invisible to lookups, which can be brought back with Restore until it is purged with PurgeTombstones.
+func WithSoftDelete() Option {
+	return func(c *config) {
+		c.softDelete = true
+	}
+}
+
+// WithAuditLog records every mutation with its time and, for the ctx-accepting variants such as InsertCtx,
+// the actor and request ID attached with ContextWithActor and ContextWithRequestID. Entries are read with AuditLog.
+func WithAuditLog() Option {
+	return func(c *config) {
+		c.audit = true
+	}
+}
+
+// WithMaxEntries limits the BiMap to n entries. Inserting a new key into a full BiMap fails with ErrQuotaExceeded:
+// TryInsert returns the error and Insert panics with it. Replacing the value of an existing key is always allowed.
+func WithMaxEntries(n int) Option {
+	return func(c *config) {
+		c.maxEntries = n
+	}
+}
+
+// WithKeyValidator runs fn on every key inserted into the BiMap, rejecting the insert with an error wrapping
+// ErrInvalidKey and fn's error if it fails. The function's parameter type must match the BiMap's key type.
+func WithKeyValidator[K any](fn func(K) error) Option {
+	return func(c *config) {
+		c.keyValidators = append(c.keyValidators, fn)
+	}
+}
+
+// WithValueValidator runs fn on every value inserted into the BiMap, rejecting the insert with an error wrapping
+// ErrInvalidValue and fn's error if it fails. The function's parameter type must match the BiMap's value type.
+func WithValueValidator[V any](fn func(V) error) Option {
+	return func(c *config) {
+		c.valueValidators = append(c.valueValidators, fn)
+	}
+}
+
+// WithKeyNormalizer applies fn to every key on insert and lookup, so keys with the same normalized form, such as
+// strings.ToLower or strings.TrimSpace of each other, resolve to the same entry, which stores the normalized key.
+// It works for any key type, including comparable structs. Normalizers run in order, after WithUnicodeNormalization.
+// The function's parameter type must match the BiMap's key type.
+func WithKeyNormalizer[K any](fn func(K) K) Option {
+	return func(c *config) {
+		c.keyNormalizers = append(c.keyNormalizers, fn)
+	}
+}
+
+// WithValueNormalizer is like WithKeyNormalizer for values. The function's parameter type must match the BiMap's
+// value type.
+func WithValueNormalizer[V any](fn func(V) V) Option {
+	return func(c *config) {
+		c.valueNormalizers = append(c.valueNormalizers, fn)
+	}
+}
+
+// WithKeyPattern rejects inserts of string keys that do not match re with an error wrapping ErrInvalidKey.
+// Anchor the expression with ^ and $ to require a full match. It panics at construction time if the key type is not a string type.
+func WithKeyPattern(re *regexp.Regexp) Option {
+	return func(c *config) {
+		c.keyPattern = re
+	}
+}
+
+// WithHealthTracking records the time of the most recent write, reported by Health and HealthCheck.
+func WithHealthTracking() Option {
+	return func(c *config) {
+		c.healthTracking = true
+	}
+}
+
+// WithoutLocking turns the BiMap's locking into no-ops, removing its cost on single-goroutine hot paths.
+// The BiMap is then not safe for concurrent use if any goroutine modifies it. Lock, RLock and the
+// other manual locking methods still succeed, but do nothing.
+func WithoutLocking() Option {
+	return func(c *config) {
+		c.noLocking = true
+	}
+}
+
+// WithMetrics reports inserts, deletes, lookup hits and misses, and the BiMap's size to c, for export to
+// systems such as expvar or Prometheus. See Collector for the calling contract.
+func WithMetrics(c Collector) Option {
+	return func(cfg *config) {
+		cfg.metrics = c
+	}
+}
+
+func applyOptions[K comparable, V comparable](b *BiMap[K, V], opts []Option) {
+	if len(opts) == 0 {
+		return
+	}
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.collator != nil {
+		requireStringType[K]("WithCollator")
+		b.fold = collationFold[K](c.collator())
+		b.folded = make(map[string]K)
+	}
+	if len(c.stringNormalizers) > 0 {
+		keyIsString, valueIsString := isStringType[K](), isStringType[V]()
+		if !keyIsString && !valueIsString {
+			panic("bimap: WithUnicodeNormalization requires string keys or values")
+		}
+		if keyIsString {
+			b.normKey = stringNormalizer[K](c.stringNormalizers)
+		}
+		if valueIsString {
+			b.normValue = stringNormalizer[V](c.stringNormalizers)
+		}
+	}
+	b.normKey = normalizer(b.normKey, c.keyNormalizers, "WithKeyNormalizer")
+	b.normValue = normalizer(b.normValue, c.valueNormalizers, "WithValueNormalizer")
+	if c.softDelete {
+		b.tombstones = make(map[K]tombstone[V])
+	}
+	b.maxEntries = c.maxEntries
+	keyValidators := c.keyValidators
+	if c.keyPattern != nil {
+		requireStringType[K]("WithKeyPattern")
+		re := c.keyPattern
+		keyValidators = append([]any{func(k K) error {
+			if !re.MatchString(stringOf(k)) {
+				return fmt.Errorf("does not match %s", re)
+			}
+			return nil
+		}}, keyValidators...)
+	}
+	b.validateKey = validator[K](keyValidators, "WithKeyValidator")
+	b.validateValue = validator[V](c.valueValidators, "WithValueValidator")
+	if c.healthTracking {
+		b.lastMutation = new(time.Time)
+	}
+	if c.audit {
+		b.audit = &auditLog[K, V]{entries: make(map[K][]AuditEntry[K, V])}
+	}
+	b.noLocking = c.noLocking
+	b.metrics = c.metrics
+	if c.contention {
+		b.contention = &contention{}
+	}
+	if c.lookupStats {
+		b.lookups = &lookups{}
+	}
+	if c.interner != nil {
+		if isStringType[K]() {
+			b.internKey = stringNormalizer[K]([]func(string) string{c.interner.Intern})
+		}
+		if isStringType[V]() {
+			b.internValue = stringNormalizer[V]([]func(string) string{c.interner.Intern})
+		}
+	}
+}
+
+// validator combines fns, which must all be func(T) error, into a single function running them in order.
+func validator[T any](fns []any, option string) func(T) error {
+	if len(fns) == 0 {
+		return nil
+	}
+	typed := make([]func(T) error, len(fns))
+	for i, fn := range fns {
+		f, ok := fn.(func(T) error)
+		if !ok {
+			panic(fmt.Sprintf("bimap: %s expects a func(%s) error, got %T", option, reflect.TypeOf((*T)(nil)).Elem(), fn))
+		}
+		typed[i] = f
+	}
+	return func(t T) error {
+		for _, f := range typed {
+			if err := f(t); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// normalizer combines first, which may be nil, and fns, which must all be func(T) T, into a single function running
+// them in order.
+func normalizer[T any](first func(T) T, fns []any, option string) func(T) T {
+	if len(fns) == 0 {
+		return first
+	}
+	typed := make([]func(T) T, 0, len(fns)+1)
+	if first != nil {
+		typed = append(typed, first)
+	}
+	for _, fn := range fns {
+		f, ok := fn.(func(T) T)
+		if !ok {
+			t := reflect.TypeOf((*T)(nil)).Elem()
+			panic(fmt.Sprintf("bimap: %s expects a func(%s) %s, got %T", option, t, t, fn))
+		}
+		typed = append(typed, f)
+	}
+	return func(t T) T {
+		for _, f := range typed {
+			t = f(t)
+		}
+		return t
+	}
+}
+
+func stringNormalizer[T any](fns []func(string) string) func(T) T {
+	return func(t T) T {
+		s := stringOf(t)
+		for _, fn := range fns {
+			s = fn(s)
+		}
+		return fromString[T](s)
+	}
+}
+
+// collationFold returns a function mapping a string key to its collation key.
+// Collators are not safe for concurrent use, so calls are serialized.
+func collationFold[K comparable](c *collate.Collator) func(K) string {
+	var mu sync.Mutex
+	var buf collate.Buffer
+	return func(k K) string {
+		mu.Lock()
+		defer mu.Unlock()
+		key := string(c.KeyFromString(&buf, stringOf(k)))
+		buf.Reset()
+		return key
+	}
+}
+
+func isStringType[T any]() bool {
+	return reflect.TypeOf((*T)(nil)).Elem().Kind() == reflect.String
+}
+
+func requireStringType[T any](option string) {
+	if !isStringType[T]() {
+		panic(fmt.Sprintf("bimap: %s requires a string type, got %s", option, reflect.TypeOf((*T)(nil)).Elem()))
+	}
+}
+
+func stringOf[T any](t T) string {
+	if s, ok := any(t).(string); ok {
+		return s
+	}
+	return reflect.ValueOf(t).String()
+}
+
+func fromString[T any](s string) T {
+	var t T
+	if _, ok := any(t).(string); ok {
+		return any(s).(T)
+	}
+	reflect.ValueOf(&t).Elem().SetString(s)
+	return t
+}
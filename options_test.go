@@ -0,0 +1,174 @@
+package bimap
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestWithCollator(t *testing.T) {
+	actual := NewBiMap[string, int](WithCollator(language.French, collate.IgnoreCase, collate.IgnoreDiacritics))
+	actual.Insert("Café", 1)
+
+	v, ok := actual.GetByKey("cafe")
+	assert.True(t, ok, "Collation-equal key should be found")
+	assert.Equal(t, 1, v)
+
+	actual.Insert("CAFE", 2)
+	assert.Equal(t, 1, actual.Size(), "Collation-equal key should replace the existing entry")
+	k, _ := actual.GetByValue(2)
+	assert.Equal(t, "Café", k, "The first spelling should be kept")
+
+	actual.DeleteByKey("café")
+	assert.Equal(t, 0, actual.Size())
+	assert.False(t, actual.ExistsByKey("Café"))
+}
+
+func TestWithCollator_NonStringKey(t *testing.T) {
+	assert.Panics(t, func() {
+		NewBiMap[int, int](WithCollator(language.English))
+	}, "It should panic for non-string keys")
+}
+
+func TestWithUnicodeNormalization(t *testing.T) {
+	composed, decomposed := "caf\u00e9", "cafe\u0301"
+	actual := NewBiMap[string, string](WithUnicodeNormalization(norm.NFC))
+	actual.Insert(decomposed, decomposed)
+
+	v, ok := actual.GetByKey(composed)
+	assert.True(t, ok, "Key in a different normal form should be found")
+	assert.Equal(t, composed, v, "Values should be stored normalized")
+	assert.True(t, actual.ExistsByValue(decomposed))
+
+	actual.Insert(composed, "other")
+	assert.Equal(t, 1, actual.Size(), "Keys in different normal forms should be the same entry")
+
+	actual.DeleteByValue("other")
+	assert.Equal(t, 0, actual.Size())
+}
+
+func TestWithUnicodeNormalization_NonStringSides(t *testing.T) {
+	actual := NewBiMap[int, string](WithUnicodeNormalization(norm.NFC))
+	actual.Insert(1, "café")
+	k, ok := actual.GetByValue("café")
+	assert.True(t, ok)
+	assert.Equal(t, 1, k)
+
+	assert.Panics(t, func() {
+		NewBiMap[int, int](WithUnicodeNormalization(norm.NFC))
+	}, "It should panic when neither side is a string")
+}
+
+func TestWithKeyNormalizer(t *testing.T) {
+	actual := NewBiMap[string, string](
+		WithKeyNormalizer(strings.TrimSpace),
+		WithKeyNormalizer(strings.ToLower),
+		WithValueNormalizer(strings.ToUpper),
+	)
+	actual.Insert("  Alice ", "a1")
+
+	v, ok := actual.GetByKey("ALICE")
+	assert.True(t, ok, "Keys should be matched after normalization")
+	assert.Equal(t, "A1", v, "Values should be stored normalized")
+	k, ok := actual.GetByValue("a1")
+	assert.True(t, ok, "Values should be matched after normalization")
+	assert.Equal(t, "alice", k, "Keys should be stored normalized")
+
+	actual.Insert("alice", "b2")
+	assert.Equal(t, 1, actual.Size(), "Normalized-equal keys should be the same entry")
+	actual.DeleteByKey(" ALICE")
+	assert.Equal(t, 0, actual.Size())
+}
+
+func TestWithKeyNormalizer_Struct(t *testing.T) {
+	type point struct{ X, Y int }
+	abs := func(p point) point {
+		if p.X < 0 {
+			p.X = -p.X
+		}
+		if p.Y < 0 {
+			p.Y = -p.Y
+		}
+		return p
+	}
+	actual := NewBiMap[point, string](WithKeyNormalizer(abs))
+	actual.Insert(point{-1, 2}, "a")
+	assert.True(t, actual.ExistsByKey(point{1, -2}))
+	assert.True(t, actual.Clone().ExistsByKey(point{-1, -2}), "Clones should keep the normalizer")
+}
+
+func TestWithKeyNormalizer_TypeMismatch(t *testing.T) {
+	assert.PanicsWithValue(t, "bimap: WithKeyNormalizer expects a func(string) string, got func(int) int", func() {
+		NewBiMap[string, int](WithKeyNormalizer(func(i int) int { return i }))
+	})
+}
+
+func TestWithMaxEntries(t *testing.T) {
+	actual := NewBiMap[string, int](WithMaxEntries(2))
+
+	assert.NoError(t, actual.TryInsert("a", 1))
+	assert.NoError(t, actual.TryInsert("b", 2))
+	assert.ErrorIs(t, actual.TryInsert("c", 3), ErrQuotaExceeded)
+	assert.False(t, actual.ExistsByKey("c"), "Rejected entry should not be inserted")
+
+	assert.NoError(t, actual.TryInsert("a", 10), "Replacing an existing key should be allowed")
+	assert.Panics(t, func() { actual.Insert("c", 3) }, "Insert should panic when the quota is exceeded")
+
+	actual.DeleteByKey("b")
+	assert.NoError(t, actual.TryInsert("c", 3), "Deleting should free up room")
+}
+
+func TestWithKeyAndValueValidators(t *testing.T) {
+	errEmpty := errors.New("empty")
+	actual := NewBiMap[string, int](
+		WithKeyValidator(func(k string) error {
+			if k == "" {
+				return errEmpty
+			}
+			return nil
+		}),
+		WithValueValidator(func(v int) error {
+			if v < 0 {
+				return errors.New("negative")
+			}
+			return nil
+		}),
+	)
+
+	assert.NoError(t, actual.TryInsert("a", 1))
+
+	err := actual.TryInsert("", 2)
+	assert.ErrorIs(t, err, ErrInvalidKey)
+	assert.ErrorIs(t, err, errEmpty, "The validator's error should be wrapped")
+
+	assert.ErrorIs(t, actual.TryInsert("b", -1), ErrInvalidValue)
+	assert.Equal(t, 1, actual.Size(), "Rejected entries should not be inserted")
+	assert.Panics(t, func() { actual.Insert("", 3) })
+}
+
+func TestWithKeyValidator_TypeMismatch(t *testing.T) {
+	assert.Panics(t, func() {
+		NewBiMap[string, int](WithKeyValidator(func(int) error { return nil }))
+	}, "It should panic when the validator does not match the key type")
+}
+
+func TestWithoutLocking(t *testing.T) {
+	actual := NewUnsafeBiMap[string, int]()
+	actual.Insert("a", 1)
+	v, ok := actual.GetByKey("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	actual.Lock()
+	assert.True(t, actual.TryLock(), "Locking should be a no-op")
+	assert.NoError(t, actual.LockCtx(context.Background()))
+	actual.Insert("b", 2)
+	actual.Unlock()
+	assert.Equal(t, 2, actual.Size())
+}
@@ -0,0 +1,134 @@
+package bimap
+
+import (
+	"iter"
+	"slices"
+	"sync"
+)
+
+// OrderedBiMap is a thread safe bidirectional map that remembers the order in which keys were first inserted,
+// for deterministic iteration over code tables. Deletes cost O(n) to maintain the order.
+type OrderedBiMap[K comparable, V comparable] struct {
+	s       sync.RWMutex
+	keys    []K
+	forward map[K]V
+	inverse map[V]K
+}
+
+// NewOrderedBiMap returns an empty OrderedBiMap.
+func NewOrderedBiMap[K comparable, V comparable]() *OrderedBiMap[K, V] {
+	return &OrderedBiMap[K, V]{forward: make(map[K]V), inverse: make(map[V]K)}
+}
+
+// Insert puts a key and value into the OrderedBiMap. A new key goes to the end of the order, while replacing the value
+// of an existing key keeps its position. Any existing pairing of v is removed so the map stays a bijection.
+func (b *OrderedBiMap[K, V]) Insert(k K, v V) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if owner, ok := b.inverse[v]; ok && owner != k {
+		b.deleteKey(owner)
+	}
+	if old, ok := b.forward[k]; ok {
+		delete(b.inverse, old)
+	} else {
+		b.keys = append(b.keys, k)
+	}
+	b.forward[k] = v
+	b.inverse[v] = k
+}
+
+// GetByKey returns the value for a given key and whether or not the element was present.
+func (b *OrderedBiMap[K, V]) GetByKey(k K) (V, bool) {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	v, ok := b.forward[k]
+	return v, ok
+}
+
+// GetByValue returns the key for a given value and whether or not the element was present.
+func (b *OrderedBiMap[K, V]) GetByValue(v V) (K, bool) {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	k, ok := b.inverse[v]
+	return k, ok
+}
+
+// ExistsByKey checks whether or not a key exists in the OrderedBiMap.
+func (b *OrderedBiMap[K, V]) ExistsByKey(k K) bool {
+	_, ok := b.GetByKey(k)
+	return ok
+}
+
+// ExistsByValue checks whether or not a value exists in the OrderedBiMap.
+func (b *OrderedBiMap[K, V]) ExistsByValue(v V) bool {
+	_, ok := b.GetByValue(v)
+	return ok
+}
+
+// DeleteByKey removes a key-value pair from the OrderedBiMap for a given key. Returns if the key doesn't exist.
+func (b *OrderedBiMap[K, V]) DeleteByKey(k K) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	b.deleteKey(k)
+}
+
+// DeleteByValue removes a key-value pair from the OrderedBiMap for a given value. Returns if the value doesn't exist.
+func (b *OrderedBiMap[K, V]) DeleteByValue(v V) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if k, ok := b.inverse[v]; ok {
+		b.deleteKey(k)
+	}
+}
+
+// Size returns the number of elements in the OrderedBiMap.
+func (b *OrderedBiMap[K, V]) Size() int {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	return len(b.keys)
+}
+
+// KeysInOrder returns the OrderedBiMap's keys in insertion order.
+func (b *OrderedBiMap[K, V]) KeysInOrder() []K {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	return slices.Clone(b.keys)
+}
+
+// ValuesInOrder returns the OrderedBiMap's values in the insertion order of their keys.
+func (b *OrderedBiMap[K, V]) ValuesInOrder() []V {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	values := make([]V, len(b.keys))
+	for i, k := range b.keys {
+		values[i] = b.forward[k]
+	}
+	return values
+}
+
+// All returns an iterator over the OrderedBiMap's key-value pairs in insertion order.
+// The read lock is held for the duration of the loop, so the loop body must not modify the OrderedBiMap.
+func (b *OrderedBiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		b.s.RLock()
+		defer b.s.RUnlock()
+		for _, k := range b.keys {
+			if !yield(k, b.forward[k]) {
+				return
+			}
+		}
+	}
+}
+
+// deleteKey removes k from all indexes if present. The caller must hold the lock.
+func (b *OrderedBiMap[K, V]) deleteKey(k K) {
+	v, ok := b.forward[k]
+	if !ok {
+		return
+	}
+	delete(b.forward, k)
+	delete(b.inverse, v)
+	if i := slices.Index(b.keys, k); i >= 0 {
+		b.keys = slices.Delete(b.keys, i, i+1)
+	}
+}
@@ -0,0 +1,57 @@
+package bimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedBiMap(t *testing.T) {
+	actual := NewOrderedBiMap[string, int]()
+	actual.Insert("c", 3)
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+
+	assert.Equal(t, []string{"c", "a", "b"}, actual.KeysInOrder())
+	assert.Equal(t, []int{3, 1, 2}, actual.ValuesInOrder())
+	assert.Equal(t, 3, actual.Size())
+	k, ok := actual.GetByValue(1)
+	assert.True(t, ok)
+	assert.Equal(t, "a", k)
+
+	actual.Insert("c", 30)
+	assert.Equal(t, []string{"c", "a", "b"}, actual.KeysInOrder(), "Replacing a value should keep the key's position")
+	assert.False(t, actual.ExistsByValue(3))
+
+	actual.Insert("d", 1)
+	assert.False(t, actual.ExistsByKey("a"), "Previous owner of the value should be removed")
+	assert.Equal(t, []string{"c", "b", "d"}, actual.KeysInOrder())
+
+	actual.DeleteByKey("c")
+	actual.DeleteByValue(2)
+	assert.Equal(t, []string{"d"}, actual.KeysInOrder())
+	assert.Equal(t, 1, actual.Size())
+}
+
+func TestOrderedBiMap_All(t *testing.T) {
+	actual := NewOrderedBiMap[string, int]()
+	actual.Insert("z", 26)
+	actual.Insert("y", 25)
+	actual.Insert("x", 24)
+
+	var keys []string
+	var values []int
+	for k, v := range actual.All() {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	assert.Equal(t, []string{"z", "y", "x"}, keys)
+	assert.Equal(t, []int{26, 25, 24}, values)
+
+	count := 0
+	for range actual.All() {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count, "Iteration should stop on break")
+}
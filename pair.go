@@ -0,0 +1,54 @@
+package bimap
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Pair is a single key-value association held by a bimap.
+type Pair[K comparable, V comparable] struct {
+	Key   K
+	Value V
+}
+
+// sortPairs orders pairs by key, using the natural ordering for numeric, string and boolean keys
+// and the keys' formatted representation otherwise.
+func sortPairs[K comparable, V comparable](pairs []Pair[K, V]) {
+	sort.Slice(pairs, func(i, j int) bool { return compareAny(pairs[i].Key, pairs[j].Key) < 0 })
+}
+
+// compareAny compares a and b, which must have the same type, returning -1, 0 or +1.
+func compareAny(a, b any) int {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch va.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareOrdered(va.Int(), vb.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return compareOrdered(va.Uint(), vb.Uint())
+	case reflect.Float32, reflect.Float64:
+		return compareOrdered(va.Float(), vb.Float())
+	case reflect.String:
+		return compareOrdered(va.String(), vb.String())
+	case reflect.Bool:
+		return compareOrdered(boolInt(va.Bool()), boolInt(vb.Bool()))
+	}
+	return compareOrdered(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func compareOrdered[T int64 | uint64 | float64 | string | int](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,71 @@
+package bimap
+
+import (
+	"sync"
+	"time"
+)
+
+// Pool hands out empty BiMaps for short-lived use and recycles them, keeping their allocated maps
+// across uses. Safe for concurrent use.
+type Pool[K comparable, V comparable] struct {
+	pool sync.Pool
+}
+
+// NewPool returns a Pool whose BiMaps are constructed with the given options.
+func NewPool[K comparable, V comparable](opts ...Option) *Pool[K, V] {
+	return &Pool[K, V]{pool: sync.Pool{New: func() any { return NewBiMap[K, V](opts...) }}}
+}
+
+// Get returns an empty, mutable BiMap from the pool.
+func (p *Pool[K, V]) Get() *BiMap[K, V] {
+	return p.pool.Get().(*BiMap[K, V])
+}
+
+// Put empties b, clearing its audit log, statistics and listeners and releasing WaitForKey callers with ErrClosed,
+// and returns it to the pool. b must not be used after calling Put.
+func (p *Pool[K, V]) Put(b *BiMap[K, V]) {
+	b.reset()
+	p.pool.Put(b)
+}
+
+// reset empties the BiMap, makes it mutable again, clears its audit log and statistics, releases WaitForKey callers
+// with ErrClosed and drops
+// its listeners, closing the channels of any watchers, keeping its allocated maps and options.
+func (b *BiMap[K, V]) reset() {
+	b.lock()
+	defer b.unlock()
+	for k := range b.forward {
+		delete(b.forward, k)
+	}
+	for v := range b.inverse {
+		delete(b.inverse, v)
+	}
+	for f := range b.folded {
+		delete(b.folded, f)
+	}
+	for k := range b.tombstones {
+		delete(b.tombstones, k)
+	}
+	if b.audit != nil {
+		b.audit.mu.Lock()
+		clear(b.audit.entries)
+		b.audit.mu.Unlock()
+	}
+	if b.contention != nil {
+		b.contention.reset()
+	}
+	if b.lookups != nil {
+		b.lookups.reset()
+	}
+	if b.lastMutation != nil {
+		*b.lastMutation = time.Time{}
+	}
+	b.closeWaiters()
+	b.immutable = false
+	if b.hooks != nil {
+		for _, w := range b.hooks.watchers {
+			w.stop()
+		}
+	}
+	b.hooks = nil
+}
@@ -0,0 +1,39 @@
+package bimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPool(t *testing.T) {
+	pool := NewPool[string, int]()
+
+	b := pool.Get()
+	assert.Equal(t, 0, b.Size(), "Pooled map should start empty")
+	b.Insert("a", 1)
+	b.MakeImmutable()
+	pool.Put(b)
+
+	b = pool.Get()
+	assert.Equal(t, 0, b.Size(), "Recycled map should be empty")
+	assert.NotPanics(t, func() { b.Insert("b", 2) }, "Recycled map should be mutable")
+	assert.False(t, b.ExistsByValue(1))
+}
+
+func TestPool_ResetsState(t *testing.T) {
+	pool := NewPool[string, int](WithAuditLog(), WithLookupStats(), WithContentionProfiling(), WithHealthTracking())
+
+	b := pool.Get()
+	b.Insert("a", 1)
+	b.GetByKey("a")
+	pool.Put(b)
+
+	b = pool.Get()
+	assert.Empty(t, b.AuditLog("a"), "Recycled map should have an empty audit log")
+	stats := b.Stats()
+	assert.Equal(t, LookupStats{}, *stats.Lookups, "Recycled map should have fresh lookup counts")
+	assert.Zero(t, stats.Contention.WriteLocks, "Recycled map should have fresh contention counts")
+	assert.True(t, b.lastMutation.IsZero(), "Recycled map should not report the previous use's last mutation")
+	assert.Nil(t, b.waiters)
+}
@@ -0,0 +1,55 @@
+package bimap
+
+import "math/rand/v2"
+
+// RandomPair returns a uniformly chosen pair, drawing from rng, or from the global source if rng is nil.
+// It reports false if the BiMap is empty. Go maps cannot be indexed, so it costs O(n).
+func (b *BiMap[K, V]) RandomPair(rng *rand.Rand) (K, V, bool) {
+	b.rlock()
+	defer b.runlock()
+	var zk K
+	var zv V
+	if len(b.forward) == 0 {
+		return zk, zv, false
+	}
+	i := intN(rng, len(b.forward))
+	for k, v := range b.forward {
+		if i == 0 {
+			return k, v, true
+		}
+		i--
+	}
+	return zk, zv, false
+}
+
+// Sample returns n distinct pairs chosen uniformly at random, in random order, or every pair if the BiMap holds
+// fewer than n. Like RandomPair, it scans the whole BiMap.
+func (b *BiMap[K, V]) Sample(n int) []Pair[K, V] {
+	b.rlock()
+	defer b.runlock()
+	if n <= 0 {
+		return nil
+	}
+	sample := make([]Pair[K, V], 0, min(n, len(b.forward)))
+	seen := 0
+	for k, v := range b.forward {
+		seen++
+		if len(sample) < n {
+			sample = append(sample, Pair[K, V]{Key: k, Value: v})
+			continue
+		}
+		// Reservoir sampling: keep each later pair with probability n/seen.
+		if j := rand.IntN(seen); j < n {
+			sample[j] = Pair[K, V]{Key: k, Value: v}
+		}
+	}
+	rand.Shuffle(len(sample), func(i, j int) { sample[i], sample[j] = sample[j], sample[i] })
+	return sample
+}
+
+func intN(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.IntN(n)
+	}
+	return rng.IntN(n)
+}
@@ -0,0 +1,46 @@
+package bimap
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap_RandomPair(t *testing.T) {
+	_, _, ok := NewBiMap[string, int]().RandomPair(nil)
+	assert.False(t, ok)
+
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	rng := rand.New(rand.NewPCG(1, 2))
+	seen := make(map[string]int)
+	for range 300 {
+		k, v, ok := actual.RandomPair(rng)
+		assert.True(t, ok)
+		assert.Equal(t, actual.GetByKeyWithFallback(k, 0), v)
+		seen[k]++
+	}
+	assert.Len(t, seen, 3, "Every pair should be chosen eventually")
+
+	_, _, ok = actual.RandomPair(nil)
+	assert.True(t, ok, "A nil rng should use the global source")
+}
+
+func TestBiMap_Sample(t *testing.T) {
+	actual := NewBiMap[int, int]()
+	for i := range 100 {
+		actual.Insert(i, -i)
+	}
+
+	sample := actual.Sample(10)
+	assert.Len(t, sample, 10)
+	distinct := make(map[int]bool)
+	for _, p := range sample {
+		assert.Equal(t, -p.Key, p.Value)
+		distinct[p.Key] = true
+	}
+	assert.Len(t, distinct, 10, "Sampled pairs should be distinct")
+
+	assert.Len(t, actual.Sample(1000), 100, "Asking for more pairs than present should return them all")
+	assert.Empty(t, actual.Sample(0))
+}
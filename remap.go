@@ -0,0 +1,288 @@
+package bimap
+
+import (
+	"context"
+	"fmt"
+)
+
+// RemapReport describes the outcome of RemapKeys or RemapValues.
+type RemapReport[T comparable] struct {
+	// Changed is the number of entries whose key or value was rewritten.
+	Changed int
+	// Collisions maps each target that more than one entry was rewritten to onto the originals that produced it.
+	Collisions map[T][]T
+}
+
+// RemapKeys rewrites every key through fn in one atomic pass. New keys are normalized and collated like inserted
+// keys, so two keys rewritten to the same key, or to keys the collator considers equal, are a collision: nothing is
+// changed and an error wrapping ErrDuplicateKey is returned alongside a report listing the collisions. If a key
+// validator rejects a new key, nothing is changed and an error wrapping ErrInvalidKey is returned. Audit logs and
+// listeners see each rewritten pair deleted under its old key and inserted under its new one, as with RenameKey.
+func (b *BiMap[K, V]) RemapKeys(fn func(K) K) (*RemapReport[K], error) {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		panic("Cannot modify immutable map")
+	}
+	report := &RemapReport[K]{}
+	// next indexes the new keys as they are produced, so resolving against it folds each onto any earlier new key
+	// it collates equal to.
+	next := b.derive(make(map[K]V, len(b.forward)), make(map[V]K, len(b.forward)))
+	sources := make(map[K]K, len(b.forward))
+	for k, v := range b.forward {
+		nk := next.resolveKey(fn(k))
+		if nk != k {
+			report.Changed++
+		}
+		if prev, ok := sources[nk]; ok {
+			if report.Collisions == nil {
+				report.Collisions = make(map[K][]K)
+			}
+			if len(report.Collisions[nk]) == 0 {
+				report.Collisions[nk] = append(report.Collisions[nk], prev)
+			}
+			report.Collisions[nk] = append(report.Collisions[nk], k)
+			continue
+		}
+		sources[nk] = k
+		next.forward[nk] = v
+		if next.fold != nil {
+			next.folded[next.fold(nk)] = nk
+		}
+	}
+	if len(report.Collisions) > 0 {
+		return report, fmt.Errorf("%w: %d keys rewritten onto existing keys", ErrDuplicateKey, len(report.Collisions))
+	}
+	for nk := range next.forward {
+		if nk == sources[nk] || b.validateKey == nil {
+			continue
+		}
+		if err := b.validateKey(nk); err != nil {
+			return report, fmt.Errorf("%w %v: %w", ErrInvalidKey, nk, err)
+		}
+	}
+
+	// Remove every rewritten pair before adding any back, so a key moved onto another rewritten key's old spelling
+	// is not clobbered.
+	ctx := context.Background()
+	for nk, v := range next.forward {
+		k := sources[nk]
+		if nk == k {
+			continue
+		}
+		delete(b.forward, k)
+		delete(b.inverse, v)
+		if b.fold != nil {
+			delete(b.folded, b.fold(k))
+		}
+		if b.audit != nil {
+			b.audit.record(ctx, AuditEntry[K, V]{Op: AuditDelete, Key: k, OldValue: v})
+		}
+		if b.metrics != nil {
+			b.metrics.Deleted()
+		}
+		b.fireDelete(k, v)
+	}
+	for nk, v := range next.forward {
+		if nk == sources[nk] {
+			continue
+		}
+		if b.internKey != nil {
+			nk = b.internKey(nk)
+		}
+		b.forward[nk] = v
+		b.inverse[v] = nk
+		if b.fold != nil {
+			b.folded[b.fold(nk)] = nk
+		}
+		if b.tombstones != nil {
+			delete(b.tombstones, nk)
+		}
+		if b.audit != nil {
+			b.audit.record(ctx, AuditEntry[K, V]{Op: AuditInsert, Key: nk, NewValue: v})
+		}
+		if b.metrics != nil {
+			b.metrics.Inserted()
+		}
+		b.fireInsert(nk, v)
+	}
+	b.touch()
+	return report, nil
+}
+
+// RemapValues rewrites every value through fn in one atomic pass. New values are normalized like inserted values. If
+// two values would be rewritten to the same value, nothing is changed and an error wrapping ErrDuplicateValue is
+// returned alongside a report listing the collisions. If a value validator rejects a new value, nothing is changed and
+// an error wrapping ErrInvalidValue is returned. Audit logs and listeners see each rewritten pair's value replaced,
+// as with RenameValue.
+func (b *BiMap[K, V]) RemapValues(fn func(V) V) (*RemapReport[V], error) {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		panic("Cannot modify immutable map")
+	}
+	report := &RemapReport[V]{}
+	inverse := make(map[V]K, len(b.inverse))
+	sources := make(map[V]V, len(b.inverse))
+	for v, k := range b.inverse {
+		nv := b.resolveValue(fn(v))
+		if nv != v {
+			report.Changed++
+		}
+		if prev, ok := sources[nv]; ok {
+			if report.Collisions == nil {
+				report.Collisions = make(map[V][]V)
+			}
+			if len(report.Collisions[nv]) == 0 {
+				report.Collisions[nv] = append(report.Collisions[nv], prev)
+			}
+			report.Collisions[nv] = append(report.Collisions[nv], v)
+			continue
+		}
+		sources[nv] = v
+		inverse[nv] = k
+	}
+	if len(report.Collisions) > 0 {
+		return report, fmt.Errorf("%w: %d values rewritten onto existing values", ErrDuplicateValue, len(report.Collisions))
+	}
+	for nv := range inverse {
+		if nv == sources[nv] || b.validateValue == nil {
+			continue
+		}
+		if err := b.validateValue(nv); err != nil {
+			return report, fmt.Errorf("%w %v: %w", ErrInvalidValue, nv, err)
+		}
+	}
+
+	// Remove every rewritten value before adding any back, so a value moved onto another rewritten value's old
+	// spelling is not clobbered.
+	for nv := range inverse {
+		if v := sources[nv]; nv != v {
+			delete(b.inverse, v)
+		}
+	}
+	for nv, k := range inverse {
+		v := sources[nv]
+		if nv == v {
+			continue
+		}
+		if b.internValue != nil {
+			nv = b.internValue(nv)
+		}
+		b.forward[k] = nv
+		b.inverse[nv] = k
+		if b.audit != nil {
+			b.audit.record(context.Background(), AuditEntry[K, V]{Op: AuditUpdate, Key: k, OldValue: v, NewValue: nv})
+		}
+		if b.metrics != nil {
+			b.metrics.Inserted()
+		}
+		b.fireReplace(k, v, nv)
+	}
+	b.touch()
+	return report, nil
+}
+
+// RenameKey atomically moves the pair held by oldK to newK. It returns ErrNotFound if oldK is absent, ErrDuplicateKey
+// if newK is already held by another pair, ErrInvalidKey if a validator rejects newK, and ErrImmutable if the BiMap is
+// immutable. Audit logs and listeners see the pair deleted under oldK and inserted under newK.
+func (b *BiMap[K, V]) RenameKey(oldK, newK K) error {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return ErrImmutable
+	}
+	oldK = b.resolveKey(oldK)
+	v, ok := b.forward[oldK]
+	if !ok {
+		return fmt.Errorf("%w: %v", ErrNotFound, oldK)
+	}
+	newK = b.resolveNewKey(newK)
+	if newK == oldK {
+		return nil
+	}
+	if existing := b.resolveKey(newK); existing != oldK {
+		if _, ok := b.forward[existing]; ok {
+			return fmt.Errorf("%w: %v", ErrDuplicateKey, existing)
+		}
+	}
+	if b.validateKey != nil {
+		if err := b.validateKey(newK); err != nil {
+			return fmt.Errorf("%w %v: %w", ErrInvalidKey, newK, err)
+		}
+	}
+	if b.internKey != nil {
+		newK = b.internKey(newK)
+	}
+	delete(b.forward, oldK)
+	if b.fold != nil {
+		delete(b.folded, b.fold(oldK))
+		b.folded[b.fold(newK)] = newK
+	}
+	b.forward[newK] = v
+	b.inverse[v] = newK
+	b.touch()
+	if b.audit != nil {
+		ctx := context.Background()
+		b.audit.record(ctx, AuditEntry[K, V]{Op: AuditDelete, Key: oldK, OldValue: v})
+		b.audit.record(ctx, AuditEntry[K, V]{Op: AuditInsert, Key: newK, NewValue: v})
+	}
+	if b.metrics != nil {
+		b.metrics.Deleted()
+		b.metrics.Inserted()
+	}
+	b.fireDelete(oldK, v)
+	b.fireInsert(newK, v)
+	return nil
+}
+
+// RenameValue atomically moves the pair holding oldV to newV. It returns ErrNotFound if oldV is absent,
+// ErrDuplicateValue if newV is already held by another pair, ErrInvalidValue if a validator rejects newV, and
+// ErrImmutable if the BiMap is immutable. Audit logs and listeners see the key's value replaced.
+func (b *BiMap[K, V]) RenameValue(oldV, newV V) error {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return ErrImmutable
+	}
+	oldV, newV = b.resolveValue(oldV), b.resolveValue(newV)
+	k, ok := b.inverse[oldV]
+	if !ok {
+		return fmt.Errorf("%w: %v", ErrNotFound, oldV)
+	}
+	if newV == oldV {
+		return nil
+	}
+	if other, ok := b.inverse[newV]; ok {
+		return fmt.Errorf("%w: %v already held by %v", ErrDuplicateValue, newV, other)
+	}
+	if b.validateValue != nil {
+		if err := b.validateValue(newV); err != nil {
+			return fmt.Errorf("%w %v: %w", ErrInvalidValue, newV, err)
+		}
+	}
+	if b.internValue != nil {
+		newV = b.internValue(newV)
+	}
+	delete(b.inverse, oldV)
+	b.inverse[newV] = k
+	b.forward[k] = newV
+	b.touch()
+	if b.audit != nil {
+		b.audit.record(context.Background(), AuditEntry[K, V]{Op: AuditUpdate, Key: k, OldValue: oldV, NewValue: newV})
+	}
+	if b.metrics != nil {
+		b.metrics.Inserted()
+	}
+	b.fireReplace(k, oldV, newV)
+	return nil
+}
+
+// resolveNewKey normalizes a key a pair is being moved to. Unlike resolveKey it does not consult the collation
+// index, so the new spelling is kept. The caller must hold the lock.
+func (b *BiMap[K, V]) resolveNewKey(k K) K {
+	if b.normKey != nil {
+		return b.normKey(k)
+	}
+	return k
+}
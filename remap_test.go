@@ -0,0 +1,180 @@
+package bimap
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+func TestBiMap_RemapKeys(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	report, err := actual.RemapKeys(func(k string) string { return "tenant/" + k })
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Changed)
+	assert.Empty(t, report.Collisions)
+	assert.Equal(t, map[string]int{"tenant/a": 1, "tenant/b": 2}, actual.GetForwardMap())
+	k, _ := actual.GetByValue(2)
+	assert.Equal(t, "tenant/b", k, "Inverse index should follow the new keys")
+}
+
+func TestBiMap_RemapKeys_Collision(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "A": 2, "b": 3})
+
+	report, err := actual.RemapKeys(strings.ToLower)
+	assert.ErrorIs(t, err, ErrDuplicateKey)
+	assert.Len(t, report.Collisions, 1)
+	assert.ElementsMatch(t, []string{"a", "A"}, report.Collisions["a"])
+	assert.Equal(t, map[string]int{"a": 1, "A": 2, "b": 3}, actual.GetForwardMap(), "Nothing should change on collision")
+}
+
+func TestBiMap_RemapKeys_Collation(t *testing.T) {
+	actual := NewBiMap[string, int](WithCollator(language.English, collate.IgnoreCase))
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+
+	report, err := actual.RemapKeys(func(k string) string {
+		if k == "b" {
+			return "A"
+		}
+		return k
+	})
+	assert.ErrorIs(t, err, ErrDuplicateKey, "Keys the collator considers equal should collide")
+	assert.Len(t, report.Collisions, 1)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, actual.GetForwardMap(), "Nothing should change on collision")
+}
+
+func TestBiMap_RemapKeys_Validated(t *testing.T) {
+	actual := NewBiMap[string, int](WithAuditLog(), WithKeyValidator(func(k string) error {
+		if len(k) > 4 {
+			return errors.New("too long")
+		}
+		return nil
+	}))
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+
+	_, err := actual.RemapKeys(func(k string) string { return "tenant/" + k })
+	assert.ErrorIs(t, err, ErrInvalidKey)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, actual.GetForwardMap(), "Nothing should change when a key is rejected")
+
+	report, err := actual.RemapKeys(func(k string) string {
+		if k == "a" {
+			return "z"
+		}
+		return k
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Changed)
+	if log := actual.AuditLog("a"); assert.Len(t, log, 2) {
+		assert.Equal(t, AuditDelete, log[1].Op)
+	}
+	if log := actual.AuditLog("z"); assert.Len(t, log, 1) {
+		assert.Equal(t, AuditInsert, log[0].Op)
+		assert.Equal(t, 1, log[0].NewValue)
+	}
+	assert.Len(t, actual.AuditLog("b"), 1, "Unchanged keys should not be audited")
+}
+
+func TestBiMap_RemapValues(t *testing.T) {
+	actual := NewBiMapFromMap(map[int]string{1: " A ", 2: "b"})
+
+	report, err := actual.RemapValues(func(v string) string { return strings.ToLower(strings.TrimSpace(v)) })
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Changed)
+	assert.Equal(t, map[int]string{1: "a", 2: "b"}, actual.GetForwardMap())
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, actual.GetInverseMap())
+}
+
+func TestBiMap_RemapValues_Validated(t *testing.T) {
+	actual := NewBiMap[int, string](WithAuditLog(), WithValueValidator(func(v string) error {
+		if v == "" {
+			return errors.New("empty")
+		}
+		return nil
+	}))
+	actual.Insert(1, "x")
+	actual.Insert(2, "y")
+
+	_, err := actual.RemapValues(func(v string) string { return strings.TrimPrefix(v, "x") })
+	assert.ErrorIs(t, err, ErrInvalidValue)
+	assert.Equal(t, map[int]string{1: "x", 2: "y"}, actual.GetForwardMap(), "Nothing should change when a value is rejected")
+
+	_, err = actual.RemapValues(func(v string) string { return map[string]string{"x": "y", "y": "x"}[v] })
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]string{1: "y", 2: "x"}, actual.GetForwardMap(), "Swapped values should not clobber each other")
+	if log := actual.AuditLog(1); assert.Len(t, log, 2) {
+		assert.Equal(t, AuditUpdate, log[1].Op)
+		assert.Equal(t, "x", log[1].OldValue)
+		assert.Equal(t, "y", log[1].NewValue)
+	}
+}
+
+func TestBiMap_RemapValues_Collision(t *testing.T) {
+	actual := NewBiMapFromMap(map[int]string{1: "x", 2: "X"})
+
+	report, err := actual.RemapValues(strings.ToUpper)
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+	assert.ElementsMatch(t, []string{"x", "X"}, report.Collisions["X"])
+	assert.Equal(t, map[int]string{1: "x", 2: "X"}, actual.GetForwardMap(), "Nothing should change on collision")
+}
+
+func TestBiMap_RenameKey(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"old-slug": 1, "other": 2})
+
+	assert.NoError(t, actual.RenameKey("old-slug", "new-slug"))
+	assert.Equal(t, map[string]int{"new-slug": 1, "other": 2}, actual.SnapshotForward())
+	assert.Equal(t, map[int]string{1: "new-slug", 2: "other"}, actual.SnapshotInverse())
+
+	assert.NoError(t, actual.RenameKey("other", "other"))
+	assert.ErrorIs(t, actual.RenameKey("missing", "x"), ErrNotFound)
+	assert.ErrorIs(t, actual.RenameKey("new-slug", "other"), ErrDuplicateKey)
+	assert.Equal(t, map[string]int{"new-slug": 1, "other": 2}, actual.SnapshotForward(), "Failed renames should change nothing")
+
+	actual.MakeImmutable()
+	assert.ErrorIs(t, actual.RenameKey("other", "x"), ErrImmutable)
+}
+
+func TestBiMap_RenameKeyValidated(t *testing.T) {
+	actual := NewBiMap[string, int](WithKeyValidator(func(k string) error {
+		if strings.Contains(k, " ") {
+			return errors.New("contains a space")
+		}
+		return nil
+	}))
+	actual.Insert("a", 1)
+
+	assert.ErrorIs(t, actual.RenameKey("a", "a b"), ErrInvalidKey)
+	assert.True(t, actual.ExistsByKey("a"))
+}
+
+func TestBiMap_RenameKeyCollation(t *testing.T) {
+	actual := NewBiMap[string, int](WithCollator(language.English, collate.IgnoreCase))
+	actual.Insert("Slug", 1)
+
+	assert.NoError(t, actual.RenameKey("slug", "SLUG"), "Renaming to a different spelling of the same key should be allowed")
+	k, _ := actual.GetByValue(1)
+	assert.Equal(t, "SLUG", k)
+	v, ok := actual.GetByKey("slug")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+}
+
+func TestBiMap_RenameValue(t *testing.T) {
+	actual := NewBiMapFromMap(map[int]string{1: "old-slug", 2: "other"})
+
+	assert.NoError(t, actual.RenameValue("old-slug", "new-slug"))
+	assert.Equal(t, map[int]string{1: "new-slug", 2: "other"}, actual.SnapshotForward())
+	assert.Equal(t, map[string]int{"new-slug": 1, "other": 2}, actual.SnapshotInverse())
+
+	assert.ErrorIs(t, actual.RenameValue("missing", "x"), ErrNotFound)
+	assert.ErrorIs(t, actual.RenameValue("new-slug", "other"), ErrDuplicateValue)
+	assert.Equal(t, map[int]string{1: "new-slug", 2: "other"}, actual.SnapshotForward(), "Failed renames should change nothing")
+
+	actual.MakeImmutable()
+	assert.ErrorIs(t, actual.RenameValue("other", "x"), ErrImmutable)
+}
@@ -0,0 +1,85 @@
+package bimap
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is returned by SafeBiMap when the wrapped BiMap panics.
+type PanicError struct {
+	// Value is the value the BiMap panicked with.
+	Value any
+	// Stack is the stack trace of the goroutine at the time of the panic.
+	Stack []byte
+}
+
+// Error returns the panic value and the stack trace.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("bimap: recovered panic: %v\n%s", e.Value, e.Stack)
+}
+
+// Unwrap returns the panic value if it is an error, so errors.Is and errors.As see through the panic.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// SafeBiMap wraps a BiMap, converting panics from its mutations (such as writes to an immutable map)
+// into returned *PanicError values, for long-running servers where a stray panic must not crash the process.
+type SafeBiMap[K comparable, V comparable] struct {
+	b *BiMap[K, V]
+}
+
+// NewSafeBiMap returns a SafeBiMap wrapping b.
+func NewSafeBiMap[K comparable, V comparable](b *BiMap[K, V]) *SafeBiMap[K, V] {
+	return &SafeBiMap[K, V]{b: b}
+}
+
+// Unwrap returns the wrapped BiMap.
+func (s *SafeBiMap[K, V]) Unwrap() *BiMap[K, V] {
+	return s.b
+}
+
+// Insert puts a key and value into the wrapped BiMap, returning an error instead of panicking.
+func (s *SafeBiMap[K, V]) Insert(k K, v V) (err error) {
+	defer recoverInto(&err)
+	return s.b.TryInsert(k, v)
+}
+
+// DeleteByKey removes the pair for k from the wrapped BiMap, returning an error instead of panicking.
+func (s *SafeBiMap[K, V]) DeleteByKey(k K) (err error) {
+	defer recoverInto(&err)
+	return s.b.TryDeleteByKey(k)
+}
+
+// DeleteByValue removes the pair for v from the wrapped BiMap, returning an error instead of panicking.
+func (s *SafeBiMap[K, V]) DeleteByValue(v V) (err error) {
+	defer recoverInto(&err)
+	return s.b.TryDeleteByValue(v)
+}
+
+// GetByKey returns the value for a given key and whether or not the element was present.
+func (s *SafeBiMap[K, V]) GetByKey(k K) (v V, ok bool, err error) {
+	defer recoverInto(&err)
+	v, ok = s.b.GetByKey(k)
+	return v, ok, nil
+}
+
+// GetByValue returns the key for a given value and whether or not the element was present.
+func (s *SafeBiMap[K, V]) GetByValue(v V) (k K, ok bool, err error) {
+	defer recoverInto(&err)
+	k, ok = s.b.GetByValue(v)
+	return k, ok, nil
+}
+
+// Size returns the number of elements in the wrapped BiMap.
+func (s *SafeBiMap[K, V]) Size() int {
+	return s.b.Size()
+}
+
+// recoverInto converts a panic in the calling function into a *PanicError stored in err.
+func recoverInto(err *error) {
+	if r := recover(); r != nil {
+		*err = &PanicError{Value: r, Stack: debug.Stack()}
+	}
+}
@@ -0,0 +1,80 @@
+package bimap
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSafeBiMap(t *testing.T) {
+	actual := NewSafeBiMap(NewBiMap[string, int]())
+
+	assert.NoError(t, actual.Insert("a", 1))
+	v, ok, err := actual.GetByKey("a")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	k, ok, err := actual.GetByValue(1)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "a", k)
+
+	assert.NoError(t, actual.DeleteByValue(1))
+	assert.Equal(t, 0, actual.Size())
+}
+
+func TestSafeBiMap_RecoversPanics(t *testing.T) {
+	b := NewBiMap[string, int](WithKeyValidator(func(k string) error { panic("validator failed") }))
+	actual := NewSafeBiMap(b)
+
+	var panicErr *PanicError
+	err := actual.Insert("b", 2)
+	assert.True(t, errors.As(err, &panicErr))
+	assert.Equal(t, "validator failed", panicErr.Value)
+	assert.Contains(t, string(panicErr.Stack), "bimap.(*BiMap", "Stack should point into the BiMap")
+	assert.Equal(t, 0, actual.Unwrap().Size())
+}
+
+func TestSafeBiMap_PanickingLookupReleasesLock(t *testing.T) {
+	b := NewBiMap[string, int](WithKeyNormalizer(func(k string) string {
+		if k == "boom" {
+			panic("normalizer failed")
+		}
+		return k
+	}))
+	actual := NewSafeBiMap(b)
+
+	var panicErr *PanicError
+	_, _, err := actual.GetByKey("boom")
+	assert.True(t, errors.As(err, &panicErr))
+
+	done := make(chan error, 1)
+	go func() { done <- actual.Insert("a", 1) }()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("A panicking lookup should not leave the read lock held")
+	}
+}
+
+func TestSafeBiMap_Immutable(t *testing.T) {
+	b := NewBiMap[string, int]()
+	b.Insert("a", 1)
+	b.MakeImmutable()
+	actual := NewSafeBiMap(b)
+
+	assert.ErrorIs(t, actual.Insert("b", 2), ErrImmutable)
+	assert.ErrorIs(t, actual.DeleteByKey("a"), ErrImmutable)
+	assert.ErrorIs(t, actual.DeleteByValue(1), ErrImmutable)
+	assert.Equal(t, 1, actual.Unwrap().Size())
+}
+
+func TestSafeBiMap_ReturnsErrors(t *testing.T) {
+	actual := NewSafeBiMap(NewBiMap[string, int](WithMaxEntries(1)))
+
+	assert.NoError(t, actual.Insert("a", 1))
+	assert.ErrorIs(t, actual.Insert("b", 2), ErrQuotaExceeded, "Errors should be returned without being wrapped as panics")
+}
@@ -0,0 +1,112 @@
+package bimap
+
+import (
+	"context"
+	"fmt"
+)
+
+// ConflictPolicy decides how Union resolves a pair of the second bimap that collides with a pair of the first,
+// by sharing its key but not its value, or its value but not its key.
+type ConflictPolicy int
+
+const (
+	// ConflictFail makes Union fail with ErrDuplicateKey or ErrDuplicateValue.
+	ConflictFail ConflictPolicy = iota
+	// ConflictKeepFirst keeps the first bimap's pair and drops the colliding one.
+	ConflictKeepFirst
+	// ConflictKeepSecond keeps the second bimap's pair and drops the pairs of the first it collides with.
+	ConflictKeepSecond
+)
+
+// String returns the name of the policy.
+func (p ConflictPolicy) String() string {
+	switch p {
+	case ConflictFail:
+		return "fail"
+	case ConflictKeepFirst:
+		return "keep-first"
+	case ConflictKeepSecond:
+		return "keep-second"
+	}
+	return fmt.Sprintf("ConflictPolicy(%d)", int(p))
+}
+
+// Union returns a new BiMap holding the pairs of both a and b, resolving collisions with onConflict.
+// The result has a's options: b's pairs are normalized, collated, validated and counted against the size limit as
+// TryInsert would, so keys that only collide once normalized or collated are collisions too, and a rejected pair
+// fails the Union. a and b are never locked at the same time.
+func Union[K comparable, V comparable](a, b *BiMap[K, V], onConflict ConflictPolicy) (*BiMap[K, V], error) {
+	forward, other := a.SnapshotForward(), b.SnapshotForward()
+	inverse := make(map[V]K, len(forward)+len(other))
+	for k, v := range forward {
+		inverse[v] = k
+	}
+	result := a.deriveFrom(forward, inverse)
+	// Building the result is not a change to it, so it is kept out of the result's audit log and tombstones.
+	audit, tombstones := result.audit, result.tombstones
+	result.audit, result.tombstones = nil, nil
+	ctx := context.Background()
+	for k, v := range other {
+		k, v = result.resolveKey(k), result.resolveValue(v)
+		existing, keyTaken := result.forward[k]
+		keyTaken = keyTaken && existing != v
+		owner, valueTaken := result.inverse[v]
+		valueTaken = valueTaken && owner != k
+		switch {
+		case !keyTaken && !valueTaken:
+		case onConflict == ConflictKeepFirst:
+			continue
+		case onConflict == ConflictKeepSecond:
+			if keyTaken {
+				result.deletePair(ctx, k, existing)
+			}
+			if valueTaken {
+				result.deletePair(ctx, owner, v)
+			}
+		case keyTaken:
+			return nil, fmt.Errorf("%w: %v maps to both %v and %v", ErrDuplicateKey, k, existing, v)
+		default:
+			return nil, fmt.Errorf("%w: %v held by both %v and %v", ErrDuplicateValue, v, owner, k)
+		}
+		if err := result.insertResolved(ctx, k, v); err != nil {
+			return nil, err
+		}
+	}
+	result.audit, result.tombstones = audit, tombstones
+	return result, nil
+}
+
+// Intersect returns a new BiMap holding the pairs present in both a and b. The result has a's options.
+func Intersect[K comparable, V comparable](a, b *BiMap[K, V]) *BiMap[K, V] {
+	forward, other := a.SnapshotForward(), b.SnapshotForward()
+	inverse := make(map[V]K)
+	for k, v := range forward {
+		if ov, ok := other[k]; ok && ov == v {
+			inverse[v] = k
+		} else {
+			delete(forward, k)
+		}
+	}
+	return a.deriveFrom(forward, inverse)
+}
+
+// Difference returns a new BiMap holding the pairs of a that are not present in b. The result has a's options.
+func Difference[K comparable, V comparable](a, b *BiMap[K, V]) *BiMap[K, V] {
+	forward, other := a.SnapshotForward(), b.SnapshotForward()
+	inverse := make(map[V]K)
+	for k, v := range forward {
+		if ov, ok := other[k]; ok && ov == v {
+			delete(forward, k)
+		} else {
+			inverse[v] = k
+		}
+	}
+	return a.deriveFrom(forward, inverse)
+}
+
+// deriveFrom is derive for callers that don't hold the lock.
+func (b *BiMap[K, V]) deriveFrom(forward map[K]V, inverse map[V]K) *BiMap[K, V] {
+	b.rlock()
+	defer b.runlock()
+	return b.derive(forward, inverse)
+}
@@ -0,0 +1,89 @@
+package bimap
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+func TestUnion(t *testing.T) {
+	a := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+	b := NewBiMapFromMap(map[string]int{"b": 2, "c": 3})
+
+	for _, policy := range []ConflictPolicy{ConflictFail, ConflictKeepFirst, ConflictKeepSecond} {
+		actual, err := Union(a, b, policy)
+		assert.NoError(t, err, policy.String())
+		assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3}, actual.SnapshotForward(), policy.String())
+		assert.Equal(t, map[int]string{1: "a", 2: "b", 3: "c"}, actual.SnapshotInverse(), policy.String())
+	}
+}
+
+func TestUnion_Conflicts(t *testing.T) {
+	a := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+	b := NewBiMapFromMap(map[string]int{"a": 10, "z": 2})
+
+	_, err := Union(a, b, ConflictFail)
+	assert.Error(t, err)
+	_, err = Union(a, NewBiMapFromMap(map[string]int{"a": 10}), ConflictFail)
+	assert.ErrorIs(t, err, ErrDuplicateKey)
+	_, err = Union(a, NewBiMapFromMap(map[string]int{"z": 2}), ConflictFail)
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+
+	first, err := Union(a, b, ConflictKeepFirst)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, first.SnapshotForward())
+	assert.Equal(t, map[int]string{1: "a", 2: "b"}, first.SnapshotInverse())
+
+	second, err := Union(a, b, ConflictKeepSecond)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 10, "z": 2}, second.SnapshotForward())
+	assert.Equal(t, map[int]string{10: "a", 2: "z"}, second.SnapshotInverse())
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, a.SnapshotForward(), "Inputs should be unaffected")
+}
+
+func TestUnion_Options(t *testing.T) {
+	a := NewBiMap[string, int](WithCollator(language.English, collate.IgnoreCase), WithMaxEntries(2), WithAuditLog())
+	a.Insert("Foo", 1)
+
+	_, err := Union(a, NewBiMapFromMap(map[string]int{"foo": 2}), ConflictFail)
+	assert.ErrorIs(t, err, ErrDuplicateKey, "Keys that collate as equal should collide")
+
+	second, err := Union(a, NewBiMapFromMap(map[string]int{"foo": 2}), ConflictKeepSecond)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"Foo": 2}, second.SnapshotForward(), "The collated key should be replaced, not duplicated")
+	assert.Empty(t, second.AuditLog("Foo"), "Building the result should not be audited")
+
+	_, err = Union(a, NewBiMapFromMap(map[string]int{"b": 2, "c": 3}), ConflictFail)
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+
+	validated := NewBiMap[string, int](WithKeyPattern(regexp.MustCompile(`^[a-z]+$`)))
+	_, err = Union(validated, NewBiMapFromMap(map[string]int{"BAD": 1}), ConflictFail)
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
+
+func TestIntersect(t *testing.T) {
+	a := NewBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	b := NewBiMapFromMap(map[string]int{"b": 2, "c": 30})
+
+	actual := Intersect(a, b)
+	assert.Equal(t, map[string]int{"b": 2}, actual.SnapshotForward())
+	assert.Equal(t, map[int]string{2: "b"}, actual.SnapshotInverse())
+}
+
+func TestDifference(t *testing.T) {
+	a := NewBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	b := NewBiMapFromMap(map[string]int{"b": 2, "c": 30})
+
+	actual := Difference(a, b)
+	assert.Equal(t, map[string]int{"a": 1, "c": 3}, actual.SnapshotForward())
+	assert.Equal(t, map[int]string{1: "a", 3: "c"}, actual.SnapshotInverse())
+}
+
+func TestConflictPolicy_String(t *testing.T) {
+	assert.Equal(t, "keep-second", ConflictKeepSecond.String())
+	assert.Equal(t, "ConflictPolicy(9)", ConflictPolicy(9).String())
+}
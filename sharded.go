@@ -0,0 +1,177 @@
+package bimap
+
+import (
+	"hash/maphash"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// defaultShards is the shard count used by NewShardedBiMap when none is given.
+const defaultShards = 32
+
+// ShardedBiMap is a thread safe bidirectional map that spreads its keys and values over independently locked shards,
+// so concurrent lookups don't contend on a single lock. Writers are serialized with each other but only block readers
+// of the shards they touch. Like SortedBiMap, Insert removes any existing pairing of the value so the map stays a bijection.
+type ShardedBiMap[K comparable, V comparable] struct {
+	writer    sync.Mutex
+	shards    []shard[K, V]
+	hashKey   func(K) uint64
+	hashValue func(V) uint64
+	size      atomic.Int64
+}
+
+// shard holds the forward entries whose keys hash to it and the inverse entries whose values hash to it.
+type shard[K comparable, V comparable] struct {
+	s       sync.RWMutex
+	forward map[K]V
+	inverse map[V]K
+}
+
+// NewShardedBiMap returns an empty ShardedBiMap with the given number of shards, or a default of 32 if shards is not
+// positive. Keys and values are hashed with maphash.Comparable, so any that compare equal, such as 0.0 and -0.0, share a
+// shard.
+func NewShardedBiMap[K comparable, V comparable](shards int) *ShardedBiMap[K, V] {
+	seed := maphash.MakeSeed()
+	return NewShardedBiMapFunc[K, V](shards, func(k K) uint64 { return maphash.Comparable(seed, k) }, func(v V) uint64 { return maphash.Comparable(seed, v) })
+}
+
+// NewShardedBiMapFunc returns an empty ShardedBiMap with the given number of shards that places keys and values
+// with hashKey and hashValue. Equal keys must hash equally, as must equal values.
+func NewShardedBiMapFunc[K comparable, V comparable](shards int, hashKey func(K) uint64, hashValue func(V) uint64) *ShardedBiMap[K, V] {
+	if shards <= 0 {
+		shards = defaultShards
+	}
+	b := &ShardedBiMap[K, V]{shards: make([]shard[K, V], shards), hashKey: hashKey, hashValue: hashValue}
+	for i := range b.shards {
+		b.shards[i].forward = make(map[K]V)
+		b.shards[i].inverse = make(map[V]K)
+	}
+	return b
+}
+
+// Insert puts a key and value into the ShardedBiMap. Any existing pairing of k or of v is removed so the map stays a bijection.
+func (b *ShardedBiMap[K, V]) Insert(k K, v V) {
+	b.writer.Lock()
+	defer b.writer.Unlock()
+
+	// Only writers modify the shards, so holding the writer lock makes these reads safe.
+	ks, vs := b.keyShard(k), b.valueShard(v)
+	old, replaced := b.shards[ks].forward[k]
+	owner, owned := b.shards[vs].inverse[v]
+	involved := []int{ks, vs}
+	if replaced {
+		involved = append(involved, b.valueShard(old))
+	}
+	if owned {
+		involved = append(involved, b.keyShard(owner))
+	}
+	unlock := b.lockShards(involved)
+	defer unlock()
+
+	if owned && owner != k {
+		delete(b.shards[b.keyShard(owner)].forward, owner)
+		b.size.Add(-1)
+	}
+	if replaced {
+		delete(b.shards[b.valueShard(old)].inverse, old)
+	} else {
+		b.size.Add(1)
+	}
+	b.shards[ks].forward[k] = v
+	b.shards[vs].inverse[v] = k
+}
+
+// GetByKey returns the value for a given key and whether or not the element was present.
+func (b *ShardedBiMap[K, V]) GetByKey(k K) (V, bool) {
+	sh := &b.shards[b.keyShard(k)]
+	sh.s.RLock()
+	defer sh.s.RUnlock()
+	v, ok := sh.forward[k]
+	return v, ok
+}
+
+// GetByValue returns the key for a given value and whether or not the element was present.
+func (b *ShardedBiMap[K, V]) GetByValue(v V) (K, bool) {
+	sh := &b.shards[b.valueShard(v)]
+	sh.s.RLock()
+	defer sh.s.RUnlock()
+	k, ok := sh.inverse[v]
+	return k, ok
+}
+
+// ExistsByKey checks whether or not a key exists in the ShardedBiMap.
+func (b *ShardedBiMap[K, V]) ExistsByKey(k K) bool {
+	_, ok := b.GetByKey(k)
+	return ok
+}
+
+// ExistsByValue checks whether or not a value exists in the ShardedBiMap.
+func (b *ShardedBiMap[K, V]) ExistsByValue(v V) bool {
+	_, ok := b.GetByValue(v)
+	return ok
+}
+
+// DeleteByKey removes a key-value pair from the ShardedBiMap for a given key. Returns if the key doesn't exist.
+func (b *ShardedBiMap[K, V]) DeleteByKey(k K) {
+	b.writer.Lock()
+	defer b.writer.Unlock()
+	v, ok := b.shards[b.keyShard(k)].forward[k]
+	if !ok {
+		return
+	}
+	b.deletePair(k, v)
+}
+
+// DeleteByValue removes a key-value pair from the ShardedBiMap for a given value. Returns if the value doesn't exist.
+func (b *ShardedBiMap[K, V]) DeleteByValue(v V) {
+	b.writer.Lock()
+	defer b.writer.Unlock()
+	k, ok := b.shards[b.valueShard(v)].inverse[v]
+	if !ok {
+		return
+	}
+	b.deletePair(k, v)
+}
+
+// Size returns the number of elements in the ShardedBiMap.
+func (b *ShardedBiMap[K, V]) Size() int {
+	return int(b.size.Load())
+}
+
+// Shards returns the number of shards in the ShardedBiMap.
+func (b *ShardedBiMap[K, V]) Shards() int {
+	return len(b.shards)
+}
+
+// deletePair removes the pair k, v from both indexes. The caller must hold the writer lock.
+func (b *ShardedBiMap[K, V]) deletePair(k K, v V) {
+	ks, vs := b.keyShard(k), b.valueShard(v)
+	unlock := b.lockShards([]int{ks, vs})
+	defer unlock()
+	delete(b.shards[ks].forward, k)
+	delete(b.shards[vs].inverse, v)
+	b.size.Add(-1)
+}
+
+// lockShards write-locks the given shards in ascending order, skipping duplicates, and returns a func that unlocks them.
+func (b *ShardedBiMap[K, V]) lockShards(indexes []int) func() {
+	slices.Sort(indexes)
+	indexes = slices.Compact(indexes)
+	for _, i := range indexes {
+		b.shards[i].s.Lock()
+	}
+	return func() {
+		for _, i := range indexes {
+			b.shards[i].s.Unlock()
+		}
+	}
+}
+
+func (b *ShardedBiMap[K, V]) keyShard(k K) int {
+	return int(b.hashKey(k) % uint64(len(b.shards)))
+}
+
+func (b *ShardedBiMap[K, V]) valueShard(v V) int {
+	return int(b.hashValue(v) % uint64(len(b.shards)))
+}
@@ -0,0 +1,90 @@
+package bimap
+
+import (
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShardedBiMap(t *testing.T) {
+	actual := NewShardedBiMap[string, int](4)
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+
+	assert.Equal(t, 4, actual.Shards())
+	assert.Equal(t, 2, actual.Size())
+	v, ok := actual.GetByKey("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	k, ok := actual.GetByValue(2)
+	assert.True(t, ok)
+	assert.Equal(t, "b", k)
+
+	actual.Insert("a", 10)
+	assert.False(t, actual.ExistsByValue(1), "Replaced value should be gone")
+	actual.Insert("c", 2)
+	assert.False(t, actual.ExistsByKey("b"), "Previous owner of the value should be removed")
+	assert.Equal(t, 2, actual.Size())
+
+	actual.DeleteByKey("a")
+	actual.DeleteByValue(2)
+	actual.DeleteByKey("missing")
+	assert.Equal(t, 0, actual.Size())
+	assert.False(t, actual.ExistsByKey("c"))
+}
+
+func TestShardedBiMap_DefaultShards(t *testing.T) {
+	assert.Equal(t, defaultShards, NewShardedBiMap[string, int](0).Shards())
+}
+
+func TestShardedBiMap_CustomHash(t *testing.T) {
+	actual := NewShardedBiMapFunc[string, int](8, func(k string) uint64 { return uint64(len(k)) }, func(v int) uint64 { return uint64(v) })
+	actual.Insert("abc", 3)
+	v, _ := actual.GetByKey("abc")
+	assert.Equal(t, 3, v)
+	k, _ := actual.GetByValue(3)
+	assert.Equal(t, "abc", k)
+}
+
+func TestShardedBiMap_Floats(t *testing.T) {
+	type point struct{ X, Y float64 }
+	actual := NewShardedBiMap[point, float64](64)
+	actual.Insert(point{X: 0}, 0)
+
+	negZero := math.Copysign(0, -1)
+	v, ok := actual.GetByKey(point{X: negZero})
+	assert.True(t, ok, "-0.0 should find the key stored as 0.0")
+	assert.Equal(t, 0.0, v)
+	assert.True(t, actual.ExistsByValue(negZero))
+
+	allocs := testing.AllocsPerRun(100, func() { actual.GetByKey(point{X: 1, Y: 2}) })
+	assert.Zero(t, allocs, "Hashing a struct key should not allocate")
+}
+
+func TestShardedBiMap_Concurrent(t *testing.T) {
+	actual := NewShardedBiMap[int, int](8)
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				actual.Insert(w*1000+i, w*1000+i)
+				actual.GetByValue(i)
+				if i%2 == 0 {
+					actual.DeleteByKey(w*1000 + i)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 800, actual.Size())
+	for w := 0; w < 8; w++ {
+		k, ok := actual.GetByValue(w*1000 + 1)
+		assert.True(t, ok)
+		assert.Equal(t, w*1000+1, k)
+	}
+}
@@ -0,0 +1,62 @@
+package bimap
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// tombstone records a pair removed from a BiMap built with WithSoftDelete.
+type tombstone[V comparable] struct {
+	value     V
+	deletedAt time.Time
+}
+
+// Restore brings back the pair for k deleted from a BiMap built with WithSoftDelete.
+// It returns ErrNotFound if there is no tombstone for k, and ErrDuplicateKey or ErrDuplicateValue
+// if the key or value has been reused since the deletion.
+func (b *BiMap[K, V]) Restore(k K) error {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		panic("Cannot modify immutable map")
+	}
+	k = b.resolveKey(k)
+	t, ok := b.tombstones[k]
+	if !ok {
+		return fmt.Errorf("%w: no tombstone for %v", ErrNotFound, k)
+	}
+	if _, ok := b.forward[k]; ok {
+		return fmt.Errorf("%w: %v has been reinserted", ErrDuplicateKey, k)
+	}
+	if other, ok := b.inverse[t.value]; ok {
+		return fmt.Errorf("%w: %v is now held by %v", ErrDuplicateValue, t.value, other)
+	}
+	delete(b.tombstones, k)
+	b.forward[k] = t.value
+	b.inverse[t.value] = k
+	if b.fold != nil {
+		b.folded[b.fold(k)] = k
+	}
+	b.touch()
+	if b.audit != nil {
+		b.audit.record(context.Background(), AuditEntry[K, V]{Op: AuditInsert, Key: k, NewValue: t.value})
+	}
+	b.fireInsert(k, t.value)
+	return nil
+}
+
+// PurgeTombstones permanently discards tombstones deleted more than olderThan ago and returns how many were discarded.
+func (b *BiMap[K, V]) PurgeTombstones(olderThan time.Duration) int {
+	b.lock()
+	defer b.unlock()
+	cutoff := time.Now().Add(-olderThan)
+	purged := 0
+	for k, t := range b.tombstones {
+		if t.deletedAt.Before(cutoff) {
+			delete(b.tombstones, k)
+			purged++
+		}
+	}
+	return purged
+}
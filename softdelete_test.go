@@ -0,0 +1,70 @@
+package bimap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithSoftDelete_Restore(t *testing.T) {
+	actual := NewBiMap[string, int](WithSoftDelete())
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+
+	actual.DeleteByKey("a")
+	actual.DeleteByValue(2)
+	assert.Equal(t, 0, actual.Size())
+	assert.False(t, actual.ExistsByKey("a"), "Tombstoned entries should be invisible")
+	assert.False(t, actual.ExistsByValue(2), "Tombstoned entries should be invisible")
+
+	assert.NoError(t, actual.Restore("a"))
+	assert.NoError(t, actual.Restore("b"))
+	v, _ := actual.GetByKey("a")
+	assert.Equal(t, 1, v)
+	k, _ := actual.GetByValue(2)
+	assert.Equal(t, "b", k)
+
+	assert.ErrorIs(t, actual.Restore("a"), ErrNotFound, "Tombstone should be consumed by Restore")
+}
+
+func TestWithSoftDelete_RestoreConflicts(t *testing.T) {
+	actual := NewBiMap[string, int](WithSoftDelete())
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+	actual.DeleteByKey("a")
+	actual.DeleteByKey("b")
+
+	actual.Insert("c", 1)
+	assert.ErrorIs(t, actual.Restore("a"), ErrDuplicateValue)
+
+	actual.Insert("b", 3)
+	assert.ErrorIs(t, actual.Restore("b"), ErrNotFound, "Reinserting a key should discard its tombstone")
+}
+
+func TestBiMap_PurgeTombstones(t *testing.T) {
+	actual := NewBiMap[string, int](WithSoftDelete())
+	actual.Insert("a", 1)
+	actual.DeleteByKey("a")
+
+	assert.Equal(t, 0, actual.PurgeTombstones(time.Hour), "Recent tombstones should be kept")
+	assert.Equal(t, 1, actual.PurgeTombstones(0))
+	assert.ErrorIs(t, actual.Restore("a"), ErrNotFound)
+}
+
+func TestBiMap_RestoreWithoutSoftDelete(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	actual.Insert("a", 1)
+	actual.DeleteByKey("a")
+
+	assert.ErrorIs(t, actual.Restore("a"), ErrNotFound, "Hard deletes should not be restorable")
+}
+
+func TestWithSoftDelete_Clear(t *testing.T) {
+	actual := NewBiMap[string, int](WithSoftDelete())
+	actual.Insert("a", 1)
+
+	actual.Clear()
+	assert.Equal(t, 0, actual.Size())
+	assert.NoError(t, actual.Restore("a"), "Cleared entries should be tombstoned")
+}
@@ -0,0 +1,215 @@
+package bimap
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// SortedBiMap is a thread safe bidirectional map that also keeps its keys in sorted order,
+// supporting nearest-key lookups. Inserts and deletes cost O(n) to maintain the order.
+type SortedBiMap[K comparable, V comparable] struct {
+	s       sync.RWMutex
+	compare func(a, b K) int
+	normKey func(K) K
+	// collated is set when keys that compare as equal may differ, so lookups go through the sorted keys to find the
+	// stored spelling.
+	collated bool
+	keys     []K
+	forward  map[K]V
+	inverse  map[V]K
+}
+
+// NewSortedBiMap returns an empty SortedBiMap ordering keys by their natural order.
+func NewSortedBiMap[K cmp.Ordered, V comparable]() *SortedBiMap[K, V] {
+	return NewSortedBiMapFunc[K, V](cmp.Compare[K])
+}
+
+// NewSortedBiMapFunc returns an empty SortedBiMap ordering keys with compare, which returns
+// a negative number when a < b, a positive number when a > b and zero when they are equal.
+func NewSortedBiMapFunc[K comparable, V comparable](compare func(a, b K) int) *SortedBiMap[K, V] {
+	return &SortedBiMap[K, V]{compare: compare, forward: make(map[K]V), inverse: make(map[V]K)}
+}
+
+// NewCollatedSortedBiMap returns an empty SortedBiMap ordering string keys by the given language's Unicode collation
+// rules, as WithCollator does for BiMap. Keys that collate as equal, such as "Café" and "cafe" under
+// collate.IgnoreCase and collate.IgnoreDiacritics, resolve to the same entry, and the first spelling inserted is the
+// one that is stored.
+func NewCollatedSortedBiMap[K ~string, V comparable](tag language.Tag, opts ...collate.Option) *SortedBiMap[K, V] {
+	c := collate.New(tag, opts...)
+	// A Collator reuses internal buffers, so comparisons from concurrent readers are serialized.
+	var mu sync.Mutex
+	b := NewSortedBiMapFunc[K, V](func(x, y K) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return c.CompareString(string(x), string(y))
+	})
+	b.collated = true
+	return b
+}
+
+// NewTimeBiMap returns an empty SortedBiMap keyed by time. Keys are normalized to UTC without a monotonic
+// clock reading, so equal instants are the same key regardless of location.
+func NewTimeBiMap[V comparable]() *SortedBiMap[time.Time, V] {
+	b := NewSortedBiMapFunc[time.Time, V](time.Time.Compare)
+	b.normKey = func(t time.Time) time.Time { return t.Round(0).UTC() }
+	return b
+}
+
+// Insert puts a key and value into the SortedBiMap. Any existing pairing of k or of v is removed so the map stays a bijection.
+func (b *SortedBiMap[K, V]) Insert(k K, v V) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	k = b.resolveKey(k)
+	if old, ok := b.forward[k]; ok {
+		delete(b.inverse, old)
+	} else {
+		i, _ := b.search(k)
+		b.keys = slices.Insert(b.keys, i, k)
+	}
+	if owner, ok := b.inverse[v]; ok && owner != k {
+		b.deleteKey(owner)
+	}
+	b.forward[k] = v
+	b.inverse[v] = k
+}
+
+// GetByKey returns the value for a given key and whether or not the element was present.
+func (b *SortedBiMap[K, V]) GetByKey(k K) (V, bool) {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	v, ok := b.forward[b.resolveKey(k)]
+	return v, ok
+}
+
+// GetByValue returns the key for a given value and whether or not the element was present.
+func (b *SortedBiMap[K, V]) GetByValue(v V) (K, bool) {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	k, ok := b.inverse[v]
+	return k, ok
+}
+
+// ExistsByKey checks whether or not a key exists in the SortedBiMap.
+func (b *SortedBiMap[K, V]) ExistsByKey(k K) bool {
+	_, ok := b.GetByKey(k)
+	return ok
+}
+
+// ExistsByValue checks whether or not a value exists in the SortedBiMap.
+func (b *SortedBiMap[K, V]) ExistsByValue(v V) bool {
+	_, ok := b.GetByValue(v)
+	return ok
+}
+
+// GetAtOrBefore returns the greatest key less than or equal to k and its value.
+func (b *SortedBiMap[K, V]) GetAtOrBefore(k K) (K, V, bool) {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	i, found := b.search(b.resolveKey(k))
+	if !found {
+		i--
+	}
+	return b.at(i)
+}
+
+// GetAtOrAfter returns the smallest key greater than or equal to k and its value.
+func (b *SortedBiMap[K, V]) GetAtOrAfter(k K) (K, V, bool) {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	i, _ := b.search(b.resolveKey(k))
+	return b.at(i)
+}
+
+// DeleteByKey removes a key-value pair from the SortedBiMap for a given key. Returns if the key doesn't exist.
+func (b *SortedBiMap[K, V]) DeleteByKey(k K) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	b.deleteKey(b.resolveKey(k))
+}
+
+// DeleteByValue removes a key-value pair from the SortedBiMap for a given value. Returns if the value doesn't exist.
+func (b *SortedBiMap[K, V]) DeleteByValue(v V) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	if k, ok := b.inverse[v]; ok {
+		b.deleteKey(k)
+	}
+}
+
+// DeleteRangeByKey removes every entry with a key in the half-open range [from, to) and returns how many were removed.
+func (b *SortedBiMap[K, V]) DeleteRangeByKey(from, to K) int {
+	b.s.Lock()
+	defer b.s.Unlock()
+	lo, _ := b.search(b.resolveKey(from))
+	hi, _ := b.search(b.resolveKey(to))
+	if hi <= lo {
+		return 0
+	}
+	for _, k := range b.keys[lo:hi] {
+		delete(b.inverse, b.forward[k])
+		delete(b.forward, k)
+	}
+	b.keys = slices.Delete(b.keys, lo, hi)
+	return hi - lo
+}
+
+// Size returns the number of elements in the SortedBiMap.
+func (b *SortedBiMap[K, V]) Size() int {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	return len(b.keys)
+}
+
+// Keys returns the SortedBiMap's keys in ascending order.
+func (b *SortedBiMap[K, V]) Keys() []K {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	return slices.Clone(b.keys)
+}
+
+// resolveKey returns the stored form of k after normalization and collation. The caller must hold the lock.
+func (b *SortedBiMap[K, V]) resolveKey(k K) K {
+	if b.normKey != nil {
+		k = b.normKey(k)
+	}
+	if b.collated {
+		if i, found := b.search(k); found {
+			return b.keys[i]
+		}
+	}
+	return k
+}
+
+// search returns the position of k in the sorted keys, or where it would be inserted. The caller must hold the lock.
+func (b *SortedBiMap[K, V]) search(k K) (int, bool) {
+	return slices.BinarySearchFunc(b.keys, k, b.compare)
+}
+
+// at returns the entry at position i of the sorted keys, if i is in range. The caller must hold the lock.
+func (b *SortedBiMap[K, V]) at(i int) (K, V, bool) {
+	if i < 0 || i >= len(b.keys) {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	k := b.keys[i]
+	return k, b.forward[k], true
+}
+
+// deleteKey removes k from all indexes if present. The caller must hold the lock.
+func (b *SortedBiMap[K, V]) deleteKey(k K) {
+	v, ok := b.forward[k]
+	if !ok {
+		return
+	}
+	delete(b.forward, k)
+	delete(b.inverse, v)
+	if i, found := b.search(k); found {
+		b.keys = slices.Delete(b.keys, i, i+1)
+	}
+}
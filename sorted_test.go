@@ -0,0 +1,118 @@
+package bimap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+func TestSortedBiMap(t *testing.T) {
+	actual := NewSortedBiMap[int, string]()
+	actual.Insert(30, "c")
+	actual.Insert(10, "a")
+	actual.Insert(20, "b")
+
+	assert.Equal(t, []int{10, 20, 30}, actual.Keys())
+	assert.Equal(t, 3, actual.Size())
+	v, _ := actual.GetByKey(20)
+	assert.Equal(t, "b", v)
+	k, _ := actual.GetByValue("c")
+	assert.Equal(t, 30, k)
+
+	actual.Insert(40, "a")
+	assert.False(t, actual.ExistsByKey(10), "Previous owner of the value should be removed")
+	assert.Equal(t, []int{20, 30, 40}, actual.Keys())
+
+	actual.DeleteByValue("b")
+	actual.DeleteByKey(40)
+	assert.Equal(t, []int{30}, actual.Keys())
+	assert.False(t, actual.ExistsByValue("a"))
+}
+
+func TestSortedBiMap_Nearest(t *testing.T) {
+	actual := NewSortedBiMap[int, string]()
+	actual.Insert(10, "a")
+	actual.Insert(20, "b")
+
+	k, v, ok := actual.GetAtOrBefore(15)
+	assert.True(t, ok)
+	assert.Equal(t, 10, k)
+	assert.Equal(t, "a", v)
+
+	k, _, ok = actual.GetAtOrBefore(20)
+	assert.True(t, ok)
+	assert.Equal(t, 20, k, "Exact matches should be returned")
+
+	k, _, ok = actual.GetAtOrAfter(15)
+	assert.True(t, ok)
+	assert.Equal(t, 20, k)
+
+	_, _, ok = actual.GetAtOrBefore(5)
+	assert.False(t, ok)
+	_, _, ok = actual.GetAtOrAfter(25)
+	assert.False(t, ok)
+}
+
+func TestNewCollatedSortedBiMap(t *testing.T) {
+	actual := NewCollatedSortedBiMap[string, int](language.French, collate.IgnoreCase, collate.IgnoreDiacritics)
+	actual.Insert("Zèbre", 3)
+	actual.Insert("éclair", 2)
+	actual.Insert("Abricot", 1)
+
+	assert.Equal(t, []string{"Abricot", "éclair", "Zèbre"}, actual.Keys(), "Keys should be in collation order, not byte order")
+	v, ok := actual.GetByKey("ECLAIR")
+	assert.True(t, ok, "Keys that collate as equal should resolve to the same entry")
+	assert.Equal(t, 2, v)
+
+	actual.Insert("zebre", 4)
+	assert.Equal(t, 3, actual.Size(), "Inserting an equal spelling should replace the entry")
+	k, _ := actual.GetByValue(4)
+	assert.Equal(t, "Zèbre", k, "The first spelling should be kept")
+
+	k, _, _ = actual.GetAtOrAfter("b")
+	assert.Equal(t, "éclair", k)
+	actual.DeleteByKey("abricot")
+	assert.False(t, actual.ExistsByValue(1))
+}
+
+func TestNewTimeBiMap(t *testing.T) {
+	actual := NewTimeBiMap[string]()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	actual.Insert(start, "v1")
+	actual.Insert(start.Add(24*time.Hour), "v2")
+
+	at, v, ok := actual.GetAtOrBefore(start.Add(12 * time.Hour))
+	assert.True(t, ok)
+	assert.Equal(t, "v1", v)
+	assert.True(t, at.Equal(start))
+
+	_, v, _ = actual.GetAtOrAfter(start.Add(time.Hour))
+	assert.Equal(t, "v2", v)
+
+	tokyo := time.FixedZone("JST", 9*60*60)
+	v, ok = actual.GetByKey(start.In(tokyo))
+	assert.True(t, ok, "The same instant in another location should be the same key")
+	assert.Equal(t, "v1", v)
+
+	k, _ := actual.GetByValue("v2")
+	assert.True(t, k.Equal(start.Add(24*time.Hour)), "Reverse lookup should return the boundary time")
+}
+
+func TestSortedBiMap_DeleteRangeByKey(t *testing.T) {
+	actual := NewSortedBiMap[int, string]()
+	for i, v := range []string{"a", "b", "c", "d", "e"} {
+		actual.Insert(i*10, v)
+	}
+
+	assert.Equal(t, 2, actual.DeleteRangeByKey(5, 30))
+	assert.Equal(t, []int{0, 30, 40}, actual.Keys())
+	assert.False(t, actual.ExistsByValue("b"), "Inverse index should be trimmed")
+	assert.False(t, actual.ExistsByValue("c"), "Inverse index should be trimmed")
+
+	assert.Equal(t, 0, actual.DeleteRangeByKey(40, 30), "Empty ranges should remove nothing")
+	assert.Equal(t, 3, actual.DeleteRangeByKey(-100, 100))
+	assert.Equal(t, 0, actual.Size())
+}
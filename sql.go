@@ -0,0 +1,196 @@
+package bimap
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// loadBatchSize is the number of rows inserted per lock acquisition when loading from a result set.
+const loadBatchSize = 1024
+
+// RowScanner is the subset of a query result set used by LoadFromRowScanner.
+// It is satisfied by *sql.Rows and by pgx.Rows.
+type RowScanner interface {
+	Next() bool
+	Scan(dest ...any) error
+	Err() error
+}
+
+// Conflict describes a row that LoadFromRows skipped.
+type Conflict[K comparable, V comparable] struct {
+	// Row is the 1-based position of the row in the result set.
+	Row   int
+	Key   K
+	Value V
+	// Err is ErrDuplicateKey, ErrDuplicateValue or the error that rejected the row.
+	Err error
+}
+
+// ConflictError lists the rows skipped by LoadFromRows because they conflicted with existing entries or were rejected.
+type ConflictError[K comparable, V comparable] struct {
+	Conflicts []Conflict[K, V]
+}
+
+// Error summarizes the conflicts.
+func (e *ConflictError[K, V]) Error() string {
+	lines := make([]string, len(e.Conflicts))
+	for i, c := range e.Conflicts {
+		lines[i] = fmt.Sprintf("row %d (%v, %v): %v", c.Row, c.Key, c.Value, c.Err)
+	}
+	return fmt.Sprintf("bimap: %d conflicting rows: %s", len(e.Conflicts), strings.Join(lines, "; "))
+}
+
+// Unwrap returns the error of each conflict, so errors.Is(err, ErrDuplicateValue) reports whether any row had that problem.
+func (e *ConflictError[K, V]) Unwrap() []error {
+	errs := make([]error, len(e.Conflicts))
+	for i, c := range e.Conflicts {
+		errs[i] = c.Err
+	}
+	return errs
+}
+
+// LoadFromRows scans a two-column (key, value) result set into the BiMap, inserting rows in batches.
+// Rows whose key is already mapped to a different value, or whose value is already held by a different key,
+// are skipped and reported in a *ConflictError once the whole result set has been read. Rows identical to an existing
+// pair are skipped silently. It returns ErrImmutable if the BiMap is immutable. rows is not closed.
+func (b *BiMap[K, V]) LoadFromRows(rows *sql.Rows) error {
+	return b.LoadFromRowScanner(rows)
+}
+
+// LoadFromRowScanner is LoadFromRows for any result set implementing RowScanner, such as pgx.Rows.
+func (b *BiMap[K, V]) LoadFromRowScanner(rows RowScanner) error {
+	var conflicts []Conflict[K, V]
+	batch := make([]Pair[K, V], 0, loadBatchSize)
+	row := 0
+	flush := func() error {
+		skipped, err := b.loadBatch(batch, row-len(batch))
+		conflicts = append(conflicts, skipped...)
+		batch = batch[:0]
+		return err
+	}
+	for rows.Next() {
+		var p Pair[K, V]
+		if err := rows.Scan(&p.Key, &p.Value); err != nil {
+			return fmt.Errorf("bimap: scanning row %d: %w", row+1, err)
+		}
+		batch = append(batch, p)
+		row++
+		if len(batch) == loadBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	if len(conflicts) > 0 {
+		return &ConflictError[K, V]{Conflicts: conflicts}
+	}
+	return nil
+}
+
+// loadBatch inserts pairs under a single lock acquisition, returning the rows it skipped.
+// offset is the number of rows that precede the batch in the result set.
+func (b *BiMap[K, V]) loadBatch(pairs []Pair[K, V], offset int) ([]Conflict[K, V], error) {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return nil, ErrImmutable
+	}
+	var conflicts []Conflict[K, V]
+	for i, p := range pairs {
+		k, v := b.resolveKey(p.Key), b.resolveValue(p.Value)
+		existing, held := b.forward[k]
+		owner, taken := b.inverse[v]
+		var err error
+		switch {
+		case held && existing == v:
+			continue
+		case held:
+			err = ErrDuplicateKey
+		case taken && owner != k:
+			err = ErrDuplicateValue
+		default:
+			err = b.insertResolved(context.Background(), k, v)
+		}
+		if err != nil {
+			conflicts = append(conflicts, Conflict[K, V]{Row: offset + i + 1, Key: p.Key, Value: p.Value, Err: err})
+		}
+	}
+	return conflicts, nil
+}
+
+// Value implements driver.Valuer, storing the BiMap as a JSON object of its pairs,
+// so a BiMap field on a GORM or database/sql model persists to a JSON or JSONB column.
+func (b *BiMap[K, V]) Value() (driver.Value, error) {
+	b.rlock()
+	defer b.runlock()
+	return json.Marshal(b.forward)
+}
+
+// Scan implements sql.Scanner, replacing the BiMap's contents with the JSON object in src as UnmarshalJSON does.
+// A NULL column yields an empty BiMap. It returns ErrDuplicateValue if two keys share a value, ErrImmutable if the
+// BiMap is immutable, or the first insert error, leaving the BiMap unchanged.
+func (b *BiMap[K, V]) Scan(src any) error {
+	forward, _, err := scanJSON[K, V](src)
+	if err != nil {
+		return err
+	}
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return ErrImmutable
+	}
+	return b.replaceContents(forward)
+}
+
+// Value implements driver.Valuer, storing the ImmutableBiMap as a JSON object of its pairs.
+func (b *ImmutableBiMap[K, V]) Value() (driver.Value, error) {
+	return json.Marshal(b.forward)
+}
+
+// Scan implements sql.Scanner, filling an empty ImmutableBiMap with the JSON object in src. A NULL column yields an
+// empty map. It returns ErrImmutable if the ImmutableBiMap already holds pairs and ErrDuplicateValue if two keys share
+// a value.
+func (b *ImmutableBiMap[K, V]) Scan(src any) error {
+	if err := b.checkDecodable(); err != nil {
+		return err
+	}
+	forward, inverse, err := scanJSON[K, V](src)
+	if err != nil {
+		return err
+	}
+	b.forward, b.inverse = forward, inverse
+	return nil
+}
+
+func scanJSON[K comparable, V comparable](src any) (map[K]V, map[V]K, error) {
+	var data []byte
+	switch src := src.(type) {
+	case nil:
+		return make(map[K]V), make(map[V]K), nil
+	case []byte:
+		data = src
+	case string:
+		data = []byte(src)
+	default:
+		return nil, nil, fmt.Errorf("bimap: cannot scan %T", src)
+	}
+	forward := make(map[K]V)
+	if err := json.Unmarshal(data, &forward); err != nil {
+		return nil, nil, err
+	}
+	inverse, err := invert(forward)
+	if err != nil {
+		return nil, nil, err
+	}
+	return forward, inverse, nil
+}
@@ -0,0 +1,145 @@
+package bimap
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeRows is an in-memory RowScanner.
+type fakeRows struct {
+	rows [][2]any
+	pos  int
+	err  error
+}
+
+func (r *fakeRows) Next() bool {
+	r.pos++
+	return r.pos <= len(r.rows)
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	row := r.rows[r.pos-1]
+	for i, d := range dest {
+		switch d := d.(type) {
+		case *string:
+			s, ok := row[i].(string)
+			if !ok {
+				return fmt.Errorf("column %d is not a string", i)
+			}
+			*d = s
+		case *int:
+			n, ok := row[i].(int)
+			if !ok {
+				return fmt.Errorf("column %d is not an int", i)
+			}
+			*d = n
+		}
+	}
+	return nil
+}
+
+func (r *fakeRows) Err() error { return r.err }
+
+func TestBiMap_LoadFromRowScanner(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	rows := &fakeRows{}
+	for i := 0; i < loadBatchSize+10; i++ {
+		rows.rows = append(rows.rows, [2]any{fmt.Sprint("k", i), i})
+	}
+
+	assert.NoError(t, actual.LoadFromRowScanner(rows))
+	assert.Equal(t, loadBatchSize+10, actual.Size())
+	k, _ := actual.GetByValue(loadBatchSize + 5)
+	assert.Equal(t, fmt.Sprint("k", loadBatchSize+5), k)
+}
+
+func TestBiMap_LoadFromRowScanner_Conflicts(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	actual.Insert("a", 1)
+	rows := &fakeRows{rows: [][2]any{{"a", 1}, {"a", 2}, {"b", 1}, {"c", 3}}}
+
+	err := actual.LoadFromRowScanner(rows)
+	var conflictErr *ConflictError[string, int]
+	assert.True(t, errors.As(err, &conflictErr))
+	assert.Len(t, conflictErr.Conflicts, 2)
+	assert.Equal(t, Conflict[string, int]{Row: 2, Key: "a", Value: 2, Err: ErrDuplicateKey}, conflictErr.Conflicts[0])
+	assert.Equal(t, 3, conflictErr.Conflicts[1].Row)
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+
+	assert.Equal(t, map[string]int{"a": 1, "c": 3}, actual.GetForwardMap(), "Non-conflicting rows should be loaded")
+}
+
+func TestBiMap_LoadFromRowScanner_Resolved(t *testing.T) {
+	calls := 0
+	actual := NewBiMap[string, int](WithAuditLog(), WithKeyNormalizer(func(k string) string {
+		calls++
+		return k
+	}))
+	replaced := 0
+	actual.OnReplace(func(string, int, int) { replaced++ })
+
+	assert.NoError(t, actual.LoadFromRowScanner(&fakeRows{rows: [][2]any{{"a", 1}, {"a", 1}}}))
+	assert.Equal(t, 2, calls, "Each row's key should be normalized once")
+	assert.Zero(t, replaced, "An identical duplicate row should not replace the pair")
+	assert.Len(t, actual.AuditLog("a"), 1)
+
+	actual.MakeImmutable()
+	assert.ErrorIs(t, actual.LoadFromRowScanner(&fakeRows{rows: [][2]any{{"b", 2}}}), ErrImmutable)
+}
+
+func TestBiMap_LoadFromRowScanner_Errors(t *testing.T) {
+	actual := NewBiMap[string, int]()
+
+	err := actual.LoadFromRowScanner(&fakeRows{rows: [][2]any{{"a", "not a number"}}})
+	assert.ErrorContains(t, err, "scanning row 1")
+
+	errQuery := errors.New("connection reset")
+	err = actual.LoadFromRowScanner(&fakeRows{err: errQuery})
+	assert.ErrorIs(t, err, errQuery)
+}
+
+func TestBiMap_ValueAndScan(t *testing.T) {
+	original := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	stored, err := original.Value()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"a":1,"b":2}`, string(stored.([]byte)))
+
+	var scanned BiMap[string, int]
+	assert.NoError(t, scanned.Scan(stored), "Zero value BiMap should be scannable")
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, scanned.GetForwardMap())
+	k, _ := scanned.GetByValue(2)
+	assert.Equal(t, "b", k, "Inverse index should be rebuilt")
+
+	assert.NoError(t, scanned.Scan(`{"c":3}`))
+	assert.Equal(t, map[string]int{"c": 3}, scanned.GetForwardMap(), "Scan should replace the contents")
+
+	assert.NoError(t, scanned.Scan(nil))
+	assert.Equal(t, 0, scanned.Size())
+	scanned.Insert("d", 4)
+	assert.Equal(t, 1, scanned.Size(), "Scanned NULL should be usable")
+
+	assert.ErrorIs(t, scanned.Scan(`{"a":1,"b":1}`), ErrDuplicateValue)
+	assert.Error(t, scanned.Scan(42))
+
+	limited := NewBiMap[string, int](WithMaxEntries(1))
+	assert.ErrorIs(t, limited.Scan(stored), ErrQuotaExceeded)
+	assert.Equal(t, 0, limited.Size(), "A rejected scan should leave the BiMap unchanged")
+
+	scanned.MakeImmutable()
+	assert.ErrorIs(t, scanned.Scan(stored), ErrImmutable)
+}
+
+func TestImmutableBiMap_ValueAndScan(t *testing.T) {
+	stored, err := NewImmutableBiMapFromMap(map[string]int{"a": 1}).Value()
+	assert.NoError(t, err)
+
+	var scanned ImmutableBiMap[string, int]
+	assert.NoError(t, scanned.Scan(stored))
+	k, _ := scanned.GetByValue(1)
+	assert.Equal(t, "a", k)
+	assert.ErrorIs(t, scanned.Scan(`{"b":2}`), ErrImmutable, "A populated ImmutableBiMap should not be overwritten")
+}
@@ -0,0 +1,215 @@
+package bimap
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Stats is a point-in-time summary of a BiMap's state and usage.
+type Stats struct {
+	// Size is the number of elements in the BiMap.
+	Size int
+	// Contention holds lock wait times. It is nil unless the BiMap was built with WithContentionProfiling.
+	Contention *ContentionStats
+	// Lookups holds lookup hit and miss counts. It is nil unless the BiMap was built with WithLookupStats.
+	Lookups *LookupStats
+}
+
+// LookupStats counts lookups that found or missed an entry, separately for lookups by key (forward) and by value
+// (inverse). The counts are cumulative, so they can be exported as monotonic counters.
+type LookupStats struct {
+	ForwardHits   int64
+	ForwardMisses int64
+	InverseHits   int64
+	InverseMisses int64
+}
+
+// lookups accumulates lookup outcomes. Fields are updated atomically.
+type lookups struct {
+	forwardHits, forwardMisses, inverseHits, inverseMisses atomic.Int64
+}
+
+func (l *lookups) snapshot() *LookupStats {
+	return &LookupStats{
+		ForwardHits:   l.forwardHits.Load(),
+		ForwardMisses: l.forwardMisses.Load(),
+		InverseHits:   l.inverseHits.Load(),
+		InverseMisses: l.inverseMisses.Load(),
+	}
+}
+
+func (l *lookups) reset() {
+	l.forwardHits.Store(0)
+	l.forwardMisses.Store(0)
+	l.inverseHits.Store(0)
+	l.inverseMisses.Store(0)
+}
+
+// recordLookup reports the outcome of a lookup by key, or by value if inverse is set, to the BiMap's lookup counters
+// and Collector, if any.
+func (b *BiMap[K, V]) recordLookup(inverse, found bool) {
+	if l := b.lookups; l != nil {
+		switch {
+		case !inverse && found:
+			l.forwardHits.Add(1)
+		case !inverse:
+			l.forwardMisses.Add(1)
+		case found:
+			l.inverseHits.Add(1)
+		default:
+			l.inverseMisses.Add(1)
+		}
+	}
+	if b.metrics == nil {
+		return
+	}
+	if found {
+		b.metrics.Hit()
+	} else {
+		b.metrics.Missed()
+	}
+}
+
+// ContentionStats reports how long operations waited to acquire the BiMap's mutex.
+type ContentionStats struct {
+	WriteLocks    int64
+	WriteLockWait time.Duration
+	MaxWriteWait  time.Duration
+	ReadLocks     int64
+	ReadLockWait  time.Duration
+	MaxReadWait   time.Duration
+}
+
+// contention accumulates lock wait times. Fields are updated atomically.
+type contention struct {
+	writeLocks, writeWait, maxWriteWait int64
+	readLocks, readWait, maxReadWait    int64
+}
+
+func recordWait(count, total, max *int64, wait time.Duration) {
+	atomic.AddInt64(count, 1)
+	atomic.AddInt64(total, int64(wait))
+	for {
+		current := atomic.LoadInt64(max)
+		if int64(wait) <= current || atomic.CompareAndSwapInt64(max, current, int64(wait)) {
+			return
+		}
+	}
+}
+
+func (c *contention) snapshot() *ContentionStats {
+	return &ContentionStats{
+		WriteLocks:    atomic.LoadInt64(&c.writeLocks),
+		WriteLockWait: time.Duration(atomic.LoadInt64(&c.writeWait)),
+		MaxWriteWait:  time.Duration(atomic.LoadInt64(&c.maxWriteWait)),
+		ReadLocks:     atomic.LoadInt64(&c.readLocks),
+		ReadLockWait:  time.Duration(atomic.LoadInt64(&c.readWait)),
+		MaxReadWait:   time.Duration(atomic.LoadInt64(&c.maxReadWait)),
+	}
+}
+
+func (c *contention) reset() {
+	for _, n := range []*int64{&c.writeLocks, &c.writeWait, &c.maxWriteWait, &c.readLocks, &c.readWait, &c.maxReadWait} {
+		atomic.StoreInt64(n, 0)
+	}
+}
+
+// Stats returns a summary of the BiMap's state and, if enabled, its lock contention and lookup counts.
+func (b *BiMap[K, V]) Stats() Stats {
+	stats := Stats{Size: b.Size()}
+	if b.contention != nil {
+		stats.Contention = b.contention.snapshot()
+	}
+	if b.lookups != nil {
+		stats.Lookups = b.lookups.snapshot()
+	}
+	return stats
+}
+
+func (b *BiMap[K, V]) lock() {
+	if b.noLocking {
+		return
+	}
+	if b.contention == nil {
+		b.writeLock()
+		return
+	}
+	start := time.Now()
+	b.writeLock()
+	c := b.contention
+	recordWait(&c.writeLocks, &c.writeWait, &c.maxWriteWait, time.Since(start))
+}
+
+func (b *BiMap[K, V]) unlock() {
+	if b.noLocking {
+		return
+	}
+	if b.locker != nil {
+		b.locker.Unlock()
+		return
+	}
+	b.s.Unlock()
+}
+
+func (b *BiMap[K, V]) rlock() {
+	if b.noLocking {
+		return
+	}
+	if b.contention == nil {
+		b.readLock()
+		return
+	}
+	start := time.Now()
+	b.readLock()
+	c := b.contention
+	recordWait(&c.readLocks, &c.readWait, &c.maxReadWait, time.Since(start))
+}
+
+func (b *BiMap[K, V]) runlock() {
+	if b.noLocking {
+		return
+	}
+	if b.locker != nil {
+		b.locker.RUnlock()
+		return
+	}
+	b.s.RUnlock()
+}
+
+func (b *BiMap[K, V]) writeLock() {
+	if b.locker != nil {
+		b.locker.Lock()
+		return
+	}
+	b.s.Lock()
+}
+
+func (b *BiMap[K, V]) readLock() {
+	if b.locker != nil {
+		b.locker.RLock()
+		return
+	}
+	b.s.RLock()
+}
+
+func (b *BiMap[K, V]) tryLock() bool {
+	if b.noLocking {
+		return true
+	}
+	if b.locker != nil {
+		l, ok := b.locker.(interface{ TryLock() bool })
+		return ok && l.TryLock()
+	}
+	return b.s.TryLock()
+}
+
+func (b *BiMap[K, V]) tryRLock() bool {
+	if b.noLocking {
+		return true
+	}
+	if b.locker != nil {
+		l, ok := b.locker.(interface{ TryRLock() bool })
+		return ok && l.TryRLock()
+	}
+	return b.s.TryRLock()
+}
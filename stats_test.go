@@ -0,0 +1,55 @@
+package bimap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap_Stats(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	actual.Insert("a", 1)
+
+	stats := actual.Stats()
+	assert.Equal(t, 1, stats.Size)
+	assert.Nil(t, stats.Contention, "Contention should not be tracked by default")
+	assert.Nil(t, stats.Lookups, "Lookups should not be counted by default")
+}
+
+func TestWithLookupStats(t *testing.T) {
+	actual := NewBiMap[string, int](WithLookupStats())
+	actual.Insert("a", 1)
+
+	actual.GetByKey("a")
+	actual.GetByKey("a")
+	actual.GetByKey("b")
+	actual.GetByValue(2)
+	actual.GetByKeyWithFallback("c", 0)
+
+	assert.Equal(t, &LookupStats{ForwardHits: 2, ForwardMisses: 2, InverseMisses: 1}, actual.Stats().Lookups)
+	assert.Equal(t, &LookupStats{}, actual.Clone().Stats().Lookups, "Clones should start with fresh counters")
+}
+
+func TestWithContentionProfiling(t *testing.T) {
+	actual := NewBiMap[string, int](WithContentionProfiling())
+	actual.Insert("a", 1)
+
+	actual.Lock()
+	done := make(chan struct{})
+	go func() {
+		actual.Insert("b", 2)
+		close(done)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	actual.Unlock()
+	<-done
+
+	actual.GetByKey("a")
+
+	contention := actual.Stats().Contention
+	assert.NotNil(t, contention)
+	assert.Equal(t, int64(3), contention.WriteLocks, "Manual and internal write locks should be counted")
+	assert.GreaterOrEqual(t, contention.MaxWriteWait, 5*time.Millisecond, "Blocked insert should record its wait")
+	assert.GreaterOrEqual(t, contention.ReadLocks, int64(2))
+}
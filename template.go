@@ -0,0 +1,42 @@
+package bimap
+
+// Lookup returns the value for k, or nil if k is not present.
+// It is meant for templates, where {{with .Lookup "key"}} renders only present entries.
+func (b *BiMap[K, V]) Lookup(k K) any {
+	if v, ok := b.GetByKey(k); ok {
+		return v
+	}
+	return nil
+}
+
+// Entries returns a copy of the BiMap's pairs, taken under the read lock and sorted by key, so templates can
+// {{range .Entries}} in a stable order and callers can iterate keys and values together without holding the lock.
+func (b *BiMap[K, V]) Entries() []Pair[K, V] {
+	b.rlock()
+	pairs := make([]Pair[K, V], 0, len(b.forward))
+	for k, v := range b.forward {
+		pairs = append(pairs, Pair[K, V]{Key: k, Value: v})
+	}
+	b.runlock()
+	sortPairs(pairs)
+	return pairs
+}
+
+// Lookup returns the value for k, or nil if k is not present.
+// It is meant for templates, where {{with .Lookup "key"}} renders only present entries.
+func (b *ImmutableBiMap[K, V]) Lookup(k K) any {
+	if v, ok := b.forward[k]; ok {
+		return v
+	}
+	return nil
+}
+
+// Entries returns the ImmutableBiMap's pairs sorted by key, so templates can {{range .Entries}} in a stable order.
+func (b *ImmutableBiMap[K, V]) Entries() []Pair[K, V] {
+	pairs := make([]Pair[K, V], 0, len(b.forward))
+	for k, v := range b.forward {
+		pairs = append(pairs, Pair[K, V]{Key: k, Value: v})
+	}
+	sortPairs(pairs)
+	return pairs
+}
@@ -0,0 +1,46 @@
+package bimap
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap_Lookup(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	actual.Insert("a", 1)
+
+	assert.Equal(t, 1, actual.Lookup("a"))
+	assert.Nil(t, actual.Lookup("missing"))
+}
+
+func TestBiMap_Entries(t *testing.T) {
+	actual := NewBiMapFromMap(map[int]string{10: "ten", 9: "nine", 100: "hundred"})
+
+	assert.Equal(t, []Pair[int, string]{{9, "nine"}, {10, "ten"}, {100, "hundred"}}, actual.Entries(), "Entries should be sorted numerically")
+
+	entries := actual.Entries()
+	entries[0].Value = "changed"
+	actual.Insert(1, "one")
+	assert.Len(t, entries, 3, "Entries should be a snapshot")
+	assert.Equal(t, "nine", actual.GetByKeyWithFallback(9, ""), "Modifying the snapshot should not affect the BiMap")
+}
+
+func TestImmutableBiMap_LookupAndEntries(t *testing.T) {
+	m := NewImmutableBiMapFromMap(map[string]int{"b": 2, "a": 1})
+
+	assert.Equal(t, 2, m.Lookup("b"))
+	assert.Nil(t, m.Lookup("missing"))
+	assert.Equal(t, []Pair[string, int]{{"a", 1}, {"b", 2}}, m.Entries())
+}
+
+func TestBiMap_Template(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"b": 2, "a": 1})
+	tmpl := template.Must(template.New("").Parse(`{{range .Entries}}{{.Key}}={{.Value}};{{end}}{{with .Lookup "a"}}a is {{.}}{{end}}{{with .Lookup "z"}}z is {{.}}{{end}}`))
+
+	var out strings.Builder
+	assert.NoError(t, tmpl.Execute(&out, actual))
+	assert.Equal(t, "a=1;b=2;a is 1", out.String())
+}
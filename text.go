@@ -0,0 +1,88 @@
+package bimap
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+)
+
+// Keys and values implementing encoding.TextMarshaler and encoding.TextUnmarshaler, such as netip.Addr or a custom
+// enum, are written and read in their text form by the JSON, YAML and CSV encodings. encoding/json does this
+// natively; the helpers here do it for the others.
+
+func isTextMarshaler[T any]() bool {
+	return reflect.TypeFor[T]().Implements(reflect.TypeFor[encoding.TextMarshaler]())
+}
+
+func isTextUnmarshaler[T any]() bool {
+	return reflect.PointerTo(reflect.TypeFor[T]()).Implements(reflect.TypeFor[encoding.TextUnmarshaler]())
+}
+
+// formatCell converts t to text with MarshalText if it implements encoding.TextMarshaler, or fmt.Sprint otherwise.
+func formatCell[T any](t T) (string, error) {
+	if m, ok := any(t).(encoding.TextMarshaler); ok {
+		text, err := m.MarshalText()
+		return string(text), err
+	}
+	return fmt.Sprint(t), nil
+}
+
+// textForward returns forward for YAML encoding, with the sides implementing encoding.TextMarshaler converted to
+// text so YAML libraries that ignore the interface still write them as text.
+func textForward[K comparable, V comparable](forward map[K]V) (any, error) {
+	textKeys, textValues := isTextMarshaler[K](), isTextMarshaler[V]()
+	if !textKeys && !textValues {
+		return forward, nil
+	}
+	out := make(map[any]any, len(forward))
+	for k, v := range forward {
+		var key, value any = k, v
+		if textKeys {
+			s, err := formatCell(k)
+			if err != nil {
+				return nil, err
+			}
+			key = s
+		}
+		if textValues {
+			s, err := formatCell(v)
+			if err != nil {
+				return nil, err
+			}
+			value = s
+		}
+		out[key] = value
+	}
+	return out, nil
+}
+
+// unmarshalYAMLForward decodes a YAML mapping with unmarshal. If either side implements encoding.TextUnmarshaler,
+// the mapping is decoded as text and each cell converted with parseCell, so YAML libraries that ignore the interface
+// still read them. It returns ErrDuplicateKey if two keys decode to the same value.
+func unmarshalYAMLForward[K comparable, V comparable](unmarshal func(any) error) (map[K]V, error) {
+	if !isTextUnmarshaler[K]() && !isTextUnmarshaler[V]() {
+		var forward map[K]V
+		err := unmarshal(&forward)
+		return forward, err
+	}
+	var raw map[string]string
+	if err := unmarshal(&raw); err != nil {
+		return nil, err
+	}
+	forward := make(map[K]V, len(raw))
+	for ks, vs := range raw {
+		k, err := parseCell[K](ks)
+		if err != nil {
+			return nil, fmt.Errorf("bimap: key %q: %w", ks, err)
+		}
+		v, err := parseCell[V](vs)
+		if err != nil {
+			return nil, fmt.Errorf("bimap: value %q: %w", vs, err)
+		}
+		if _, ok := forward[k]; ok {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateKey, k)
+		}
+		forward[k] = v
+	}
+	return forward, nil
+}
@@ -0,0 +1,105 @@
+package bimap
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+type level int
+
+var levelNames = []string{"low", "high"}
+
+func (l level) MarshalText() ([]byte, error) {
+	if int(l) >= len(levelNames) {
+		return nil, fmt.Errorf("invalid level %d", int(l))
+	}
+	return []byte(levelNames[l]), nil
+}
+
+func (l *level) UnmarshalText(text []byte) error {
+	for i, name := range levelNames {
+		if name == string(text) {
+			*l = level(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown level %q", text)
+}
+
+func textFixture() *BiMap[netip.Addr, level] {
+	b := NewBiMap[netip.Addr, level]()
+	b.Insert(netip.MustParseAddr("10.0.0.1"), 1)
+	b.Insert(netip.MustParseAddr("10.0.0.2"), 0)
+	return b
+}
+
+func TestTextMarshaler_JSON(t *testing.T) {
+	data, err := json.Marshal(textFixture())
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"10.0.0.1": "high", "10.0.0.2": "low"}`, string(data))
+
+	decoded := NewBiMap[netip.Addr, level]()
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.True(t, textFixture().Equal(decoded))
+}
+
+func TestTextMarshaler_YAML(t *testing.T) {
+	data, err := yaml.Marshal(textFixture())
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.1: high\n10.0.0.2: low\n", string(data))
+
+	decoded := NewBiMap[netip.Addr, level]()
+	assert.NoError(t, yaml.Unmarshal(data, decoded))
+	assert.True(t, textFixture().Equal(decoded))
+
+	frozen := &ImmutableBiMap[netip.Addr, level]{}
+	assert.NoError(t, yaml.Unmarshal(data, frozen))
+	assert.Equal(t, textFixture().SnapshotForward(), frozen.GetForwardMap())
+
+	err = yaml.Unmarshal([]byte("10.0.0.1: medium\n"), decoded)
+	assert.ErrorContains(t, err, `unknown level "medium"`)
+	err = yaml.Unmarshal([]byte("10.0.0.1: low\n10.0.0.01: high\n"), decoded)
+	assert.Error(t, err, "Invalid addresses should be rejected")
+}
+
+func TestTextMarshaler_CSV(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, textFixture().WriteCSV(&buf))
+	assert.Equal(t, "10.0.0.1,high\n10.0.0.2,low\n", buf.String())
+
+	decoded, err := ReadBiMapFromCSV[netip.Addr, level](&buf, nil, nil)
+	assert.NoError(t, err)
+	assert.True(t, textFixture().Equal(decoded))
+
+	bad := NewBiMap[netip.Addr, level]()
+	bad.Insert(netip.MustParseAddr("10.0.0.3"), 7)
+	assert.ErrorContains(t, bad.WriteCSV(&buf), "invalid level 7")
+
+	m, err := LoadImmutableFromFS[netip.Addr, level](fstest.MapFS{"levels.csv": {Data: []byte("10.0.0.1,high\n")}}, "levels.csv", FormatCSV)
+	assert.NoError(t, err)
+	k, _ := m.GetByValue(1)
+	assert.Equal(t, netip.MustParseAddr("10.0.0.1"), k)
+}
+
+func TestUnmarshalYAMLForward_DuplicateKey(t *testing.T) {
+	unmarshal := func(out any) error {
+		*out.(*map[string]string) = map[string]string{"a": "low", "A": "high"}
+		return nil
+	}
+	_, err := unmarshalYAMLForward[caseless, level](unmarshal)
+	assert.ErrorIs(t, err, ErrDuplicateKey)
+}
+
+type caseless string
+
+func (c *caseless) UnmarshalText(text []byte) error {
+	*c = caseless(bytes.ToLower(text))
+	return nil
+}
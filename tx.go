@@ -0,0 +1,127 @@
+package bimap
+
+import "context"
+
+// ReadTx is a read-only view of a BiMap inside View. Its methods run under the read lock View holds, so every lookup
+// sees the same state. They must not be called after View returns, and the BiMap's own methods must not be called
+// from inside the transaction.
+type ReadTx[K comparable, V comparable] struct {
+	b *BiMap[K, V]
+}
+
+// Tx is a view of a BiMap inside Update, with ReadTx's lookups and methods to modify the BiMap. Its methods run under
+// the lock Update holds, so they must not be called after Update returns, and the BiMap's own methods must not be
+// called from inside the transaction.
+type Tx[K comparable, V comparable] struct {
+	ReadTx[K, V]
+	undo []func()
+}
+
+// View runs fn with the BiMap locked for reading, so the lookups fn makes through tx observe a single consistent
+// state without copying the BiMap.
+func (b *BiMap[K, V]) View(fn func(tx *ReadTx[K, V])) {
+	b.rlock()
+	defer b.runlock()
+	fn(&ReadTx[K, V]{b: b})
+}
+
+// Update runs fn with the BiMap locked for writing, so the changes fn makes through tx are seen by other goroutines
+// all at once. If fn returns an error or panics, its changes are undone in reverse order before the lock is released
+// and the error is returned. Listeners, the audit log and the Collector see the undo as ordinary changes. Update
+// returns ErrImmutable without calling fn if the BiMap is immutable.
+func (b *BiMap[K, V]) Update(fn func(tx *Tx[K, V]) error) error {
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return ErrImmutable
+	}
+	tx := &Tx[K, V]{ReadTx: ReadTx[K, V]{b: b}}
+	committed := false
+	defer func() {
+		if !committed {
+			tx.rollback()
+		}
+	}()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
+
+// Insert puts a key and value into the BiMap like InsertReturning: any existing pairing of k or of v is removed so
+// the BiMap stays a bijection. It returns an error if a validator or the size limit rejects the pair.
+func (tx *Tx[K, V]) Insert(k K, v V) error {
+	b := tx.b
+	d, err := b.insertReturningLocked(k, v)
+	if err != nil {
+		return err
+	}
+	rk := b.resolveKey(k)
+	nv := b.forward[rk]
+	tx.undo = append(tx.undo, func() {
+		b.deletePair(context.Background(), rk, nv)
+		if d.Replaced {
+			_ = b.insertLocked(context.Background(), rk, d.Value)
+		}
+		if d.Unlinked {
+			_ = b.insertLocked(context.Background(), d.Key, nv)
+		}
+	})
+	return nil
+}
+
+// DeleteByKey removes the pair for k and reports whether it was present.
+func (tx *Tx[K, V]) DeleteByKey(k K) bool {
+	b := tx.b
+	k = b.resolveKey(k)
+	v, ok := b.forward[k]
+	if !ok {
+		return false
+	}
+	tx.remove(k, v)
+	return true
+}
+
+// DeleteByValue removes the pair for v and reports whether it was present.
+func (tx *Tx[K, V]) DeleteByValue(v V) bool {
+	b := tx.b
+	v = b.resolveValue(v)
+	k, ok := b.inverse[v]
+	if !ok {
+		return false
+	}
+	tx.remove(k, v)
+	return true
+}
+
+func (tx *Tx[K, V]) remove(k K, v V) {
+	b := tx.b
+	b.deletePair(context.Background(), k, v)
+	tx.undo = append(tx.undo, func() {
+		_ = b.insertLocked(context.Background(), k, v)
+	})
+}
+
+// GetByKey returns the value for a given key and whether or not the element was present.
+func (tx *ReadTx[K, V]) GetByKey(k K) (V, bool) { return tx.b.GetByKeyUnlocked(k) }
+
+// GetByValue returns the key for a given value and whether or not the element was present.
+func (tx *ReadTx[K, V]) GetByValue(v V) (K, bool) { return tx.b.GetByValueUnlocked(v) }
+
+// ExistsByKey checks whether or not a key exists in the BiMap.
+func (tx *ReadTx[K, V]) ExistsByKey(k K) bool { return tx.b.ExistsByKeyUnlocked(k) }
+
+// ExistsByValue checks whether or not a value exists in the BiMap.
+func (tx *ReadTx[K, V]) ExistsByValue(v V) bool { return tx.b.ExistsByValueUnlocked(v) }
+
+// Size returns the number of elements in the BiMap.
+func (tx *ReadTx[K, V]) Size() int { return tx.b.SizeUnlocked() }
+
+// rollback undoes the transaction's changes, newest first.
+func (tx *Tx[K, V]) rollback() {
+	for i := len(tx.undo) - 1; i >= 0; i-- {
+		tx.undo[i]()
+	}
+	tx.undo = nil
+}
@@ -0,0 +1,96 @@
+package bimap
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap_Update(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	err := actual.Update(func(tx *Tx[string, int]) error {
+		v, _ := tx.GetByKey("a")
+		tx.DeleteByKey("a")
+		return tx.Insert("c", v)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"b": 2, "c": 1}, actual.SnapshotForward())
+	assert.Equal(t, map[int]string{2: "b", 1: "c"}, actual.SnapshotInverse())
+}
+
+func TestBiMap_UpdateRollback(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	errStop := errors.New("stop")
+
+	err := actual.Update(func(tx *Tx[string, int]) error {
+		assert.NoError(t, tx.Insert("a", 10), "Replacing a key's value")
+		assert.NoError(t, tx.Insert("d", 2), "Taking another key's value")
+		assert.True(t, tx.DeleteByValue(3))
+		assert.False(t, tx.DeleteByKey("missing"))
+		assert.NoError(t, tx.Insert("e", 5))
+		assert.Equal(t, 3, tx.Size())
+		return errStop
+	})
+	assert.ErrorIs(t, err, errStop)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3}, actual.SnapshotForward(), "Changes should be undone")
+	assert.Equal(t, map[int]string{1: "a", 2: "b", 3: "c"}, actual.SnapshotInverse())
+}
+
+func TestBiMap_UpdatePanic(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1})
+
+	assert.Panics(t, func() {
+		_ = actual.Update(func(tx *Tx[string, int]) error {
+			tx.DeleteByKey("a")
+			panic("boom")
+		})
+	})
+	assert.Equal(t, map[string]int{"a": 1}, actual.SnapshotForward(), "Changes should be undone on panic")
+	assert.True(t, actual.TryLock(), "The lock should be released")
+	actual.Unlock()
+}
+
+func TestBiMap_UpdateRejected(t *testing.T) {
+	actual := NewBiMap[string, int](WithMaxEntries(1))
+	actual.Insert("a", 1)
+
+	err := actual.Update(func(tx *Tx[string, int]) error {
+		return tx.Insert("b", 2)
+	})
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+	assert.Equal(t, 1, actual.Size())
+
+	actual.MakeImmutable()
+	called := false
+	err = actual.Update(func(tx *Tx[string, int]) error {
+		called = true
+		return nil
+	})
+	assert.ErrorIs(t, err, ErrImmutable)
+	assert.False(t, called)
+}
+
+func TestBiMap_View(t *testing.T) {
+	actual := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	var a, b int
+	var size int
+	actual.View(func(tx *ReadTx[string, int]) {
+		a, _ = tx.GetByKey("a")
+		b, _ = tx.GetByKey("b")
+		size = tx.Size()
+		k, ok := tx.GetByValue(2)
+		assert.True(t, ok)
+		assert.Equal(t, "b", k)
+		assert.True(t, tx.ExistsByKey("a"))
+		assert.False(t, tx.ExistsByValue(3))
+		assert.False(t, actual.TryLock(), "Writers should be blocked during View")
+	})
+	assert.Equal(t, 1, a)
+	assert.Equal(t, 2, b)
+	assert.Equal(t, 2, size)
+	assert.True(t, actual.TryLock(), "The lock should be released")
+	actual.Unlock()
+}
@@ -0,0 +1,214 @@
+package bimap
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// VersionedBiMap is a bidirectional map that keeps its most recent generations as read-only snapshots,
+// so long-running readers can work against a consistent version while writers continue.
+// Every write creates a new generation by copying the current one, which suits read-mostly tables.
+// Readers that need a generation for longer than the retention window can pin it with Snapshot or SnapshotAt.
+// Safe for concurrent use.
+type VersionedBiMap[K comparable, V comparable] struct {
+	s           sync.RWMutex
+	retain      int
+	generations []*generation[K, V]
+	pinned      map[uint64]*generation[K, V]
+}
+
+type generation[K comparable, V comparable] struct {
+	gen  uint64
+	at   time.Time
+	snap *ImmutableBiMap[K, V]
+	refs int
+}
+
+// VersionSnapshot is a generation of a VersionedBiMap pinned by Snapshot or SnapshotAt. It embeds the generation's
+// read-only view and keeps it retained, even past the retention window, until Release is called.
+type VersionSnapshot[K comparable, V comparable] struct {
+	*ImmutableBiMap[K, V]
+	owner    *VersionedBiMap[K, V]
+	g        *generation[K, V]
+	released bool
+}
+
+// NewVersionedBiMap returns an empty VersionedBiMap that retains the last retain generations, including the current one.
+func NewVersionedBiMap[K comparable, V comparable](retain int) *VersionedBiMap[K, V] {
+	if retain < 1 {
+		retain = 1
+	}
+	empty := NewImmutableBiMapFromMap(map[K]V{})
+	return &VersionedBiMap[K, V]{retain: retain, generations: []*generation[K, V]{{gen: 0, at: time.Now(), snap: empty}}}
+}
+
+// Insert creates a new generation mapping k to v. Any existing pairing of k or of v is removed so the map stays a bijection.
+func (b *VersionedBiMap[K, V]) Insert(k K, v V) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	current := b.current()
+	if owner, ok := current.GetByValue(v); ok {
+		if owner == k {
+			return
+		}
+		current = current.WithoutKeys(owner)
+	}
+	next, _ := current.WithAll(map[K]V{k: v})
+	b.push(next)
+}
+
+// DeleteByKey creates a new generation without k. Returns without creating a generation if the key doesn't exist.
+func (b *VersionedBiMap[K, V]) DeleteByKey(k K) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	current := b.current()
+	if !current.ExistsByKey(k) {
+		return
+	}
+	b.push(current.WithoutKeys(k))
+}
+
+// DeleteByValue creates a new generation without v. Returns without creating a generation if the value doesn't exist.
+func (b *VersionedBiMap[K, V]) DeleteByValue(v V) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	current := b.current()
+	k, ok := current.GetByValue(v)
+	if !ok {
+		return
+	}
+	b.push(current.WithoutKeys(k))
+}
+
+// Version returns the number of the current generation, which each change bumps. The empty map is version 0.
+func (b *VersionedBiMap[K, V]) Version() uint64 {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	return b.generations[len(b.generations)-1].gen
+}
+
+// Generation is an alias for Version, matching the naming of AtGeneration.
+func (b *VersionedBiMap[K, V]) Generation() uint64 { return b.Version() }
+
+// Current returns a read-only view of the current generation.
+func (b *VersionedBiMap[K, V]) Current() *ImmutableBiMap[K, V] {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	return b.current()
+}
+
+// AtGeneration returns a read-only view of the given generation and whether it is still retained, either within the
+// retention window or by a VersionSnapshot.
+func (b *VersionedBiMap[K, V]) AtGeneration(gen uint64) (*ImmutableBiMap[K, V], bool) {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	if g := b.find(gen); g != nil {
+		return g.snap, true
+	}
+	return nil, false
+}
+
+// Snapshot pins the current generation until the returned VersionSnapshot is released.
+func (b *VersionedBiMap[K, V]) Snapshot() *VersionSnapshot[K, V] {
+	b.s.Lock()
+	defer b.s.Unlock()
+	return b.pin(b.generations[len(b.generations)-1])
+}
+
+// SnapshotAt pins the given generation until the returned VersionSnapshot is released. It reports false if the
+// generation is no longer retained.
+func (b *VersionedBiMap[K, V]) SnapshotAt(gen uint64) (*VersionSnapshot[K, V], bool) {
+	b.s.Lock()
+	defer b.s.Unlock()
+	g := b.find(gen)
+	if g == nil {
+		return nil, false
+	}
+	return b.pin(g), true
+}
+
+// Version returns the number of the pinned generation.
+func (s *VersionSnapshot[K, V]) Version() uint64 {
+	return s.g.gen
+}
+
+// Release unpins the generation, letting the VersionedBiMap drop it once it is outside the retention window. The
+// view stays readable. Calling Release more than once has no effect.
+func (s *VersionSnapshot[K, V]) Release() {
+	b := s.owner
+	b.s.Lock()
+	defer b.s.Unlock()
+	if s.released {
+		return
+	}
+	s.released = true
+	s.g.refs--
+	if s.g.refs == 0 && b.pinned[s.g.gen] == s.g {
+		delete(b.pinned, s.g.gen)
+	}
+}
+
+// AsOf returns a read-only view of the map as it was at time t and whether that generation is still retained.
+func (b *VersionedBiMap[K, V]) AsOf(t time.Time) (*ImmutableBiMap[K, V], bool) {
+	b.s.RLock()
+	defer b.s.RUnlock()
+	// Find the first generation created after t; the one before it was current at t.
+	i := sort.Search(len(b.generations), func(i int) bool { return b.generations[i].at.After(t) })
+	if i == 0 {
+		return nil, false
+	}
+	return b.generations[i-1].snap, true
+}
+
+// GetByKey returns the value for a given key in the current generation and whether or not the element was present.
+func (b *VersionedBiMap[K, V]) GetByKey(k K) (V, bool) {
+	return b.Current().GetByKey(k)
+}
+
+// GetByValue returns the key for a given value in the current generation and whether or not the element was present.
+func (b *VersionedBiMap[K, V]) GetByValue(v V) (K, bool) {
+	return b.Current().GetByValue(v)
+}
+
+// Size returns the number of elements in the current generation.
+func (b *VersionedBiMap[K, V]) Size() int {
+	return b.Current().Size()
+}
+
+// find returns the retained generation gen, or nil. The caller must hold the lock.
+func (b *VersionedBiMap[K, V]) find(gen uint64) *generation[K, V] {
+	oldest := b.generations[0].gen
+	if gen >= oldest && gen-oldest < uint64(len(b.generations)) {
+		return b.generations[gen-oldest]
+	}
+	return b.pinned[gen]
+}
+
+// pin returns a VersionSnapshot holding g. The caller must hold the write lock.
+func (b *VersionedBiMap[K, V]) pin(g *generation[K, V]) *VersionSnapshot[K, V] {
+	g.refs++
+	return &VersionSnapshot[K, V]{ImmutableBiMap: g.snap, owner: b, g: g}
+}
+
+func (b *VersionedBiMap[K, V]) current() *ImmutableBiMap[K, V] {
+	return b.generations[len(b.generations)-1].snap
+}
+
+// push appends snap as the next generation and drops generations beyond the retention limit, moving pinned ones
+// aside until they are released. The caller must hold the lock.
+func (b *VersionedBiMap[K, V]) push(snap *ImmutableBiMap[K, V]) {
+	next := &generation[K, V]{gen: b.generations[len(b.generations)-1].gen + 1, at: time.Now(), snap: snap}
+	b.generations = append(b.generations, next)
+	if drop := len(b.generations) - b.retain; drop > 0 {
+		for _, g := range b.generations[:drop] {
+			if g.refs > 0 {
+				if b.pinned == nil {
+					b.pinned = make(map[uint64]*generation[K, V])
+				}
+				b.pinned[g.gen] = g
+			}
+		}
+		b.generations = append(b.generations[:0:0], b.generations[drop:]...)
+	}
+}
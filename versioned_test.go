@@ -0,0 +1,130 @@
+package bimap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionedBiMap_Generations(t *testing.T) {
+	actual := NewVersionedBiMap[string, int](3)
+	assert.Equal(t, uint64(0), actual.Generation())
+
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+	assert.Equal(t, uint64(2), actual.Generation())
+	assert.Equal(t, actual.Generation(), actual.Version())
+
+	v1, ok := actual.AtGeneration(1)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]int{"a": 1}, v1.GetForwardMap())
+
+	actual.DeleteByKey("a")
+	assert.Equal(t, map[string]int{"a": 1}, v1.GetForwardMap(), "Views should not change after later writes")
+	assert.Equal(t, 1, actual.Size())
+
+	_, ok = actual.AtGeneration(0)
+	assert.False(t, ok, "Generations beyond the retention limit should be dropped")
+	_, ok = actual.AtGeneration(4)
+	assert.False(t, ok, "Future generations should not exist")
+	current, ok := actual.AtGeneration(3)
+	assert.True(t, ok)
+	assert.Equal(t, actual.Current(), current)
+}
+
+func TestVersionedBiMap_Insert(t *testing.T) {
+	actual := NewVersionedBiMap[string, int](2)
+	actual.Insert("a", 1)
+	actual.Insert("b", 1)
+
+	k, _ := actual.GetByValue(1)
+	assert.Equal(t, "b", k)
+	assert.False(t, actual.Current().ExistsByKey("a"), "Previous owner of the value should be removed")
+
+	gen := actual.Generation()
+	actual.Insert("b", 1)
+	assert.Equal(t, gen, actual.Generation(), "Re-inserting an existing pair should not create a generation")
+}
+
+func TestVersionedBiMap_Delete(t *testing.T) {
+	actual := NewVersionedBiMap[string, int](2)
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+
+	actual.DeleteByValue(1)
+	actual.DeleteByValue(1)
+	actual.DeleteByKey("missing")
+	assert.Equal(t, uint64(3), actual.Generation(), "Deleting absent entries should not create generations")
+
+	_, ok := actual.GetByKey("a")
+	assert.False(t, ok)
+	v, _ := actual.GetByKey("b")
+	assert.Equal(t, 2, v)
+}
+
+func TestVersionedBiMap_AsOf(t *testing.T) {
+	before := time.Now().Add(-time.Second)
+	actual := NewVersionedBiMap[string, int](2)
+	actual.Insert("a", 1)
+	afterFirst := time.Now()
+	time.Sleep(time.Millisecond)
+	actual.Insert("b", 2)
+
+	view, ok := actual.AsOf(afterFirst)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]int{"a": 1}, view.GetForwardMap())
+
+	view, ok = actual.AsOf(time.Now())
+	assert.True(t, ok)
+	assert.Equal(t, actual.Current(), view)
+
+	_, ok = actual.AsOf(before)
+	assert.False(t, ok, "Times before the oldest retained generation should not be answerable")
+}
+
+func TestVersionedBiMap_Snapshot(t *testing.T) {
+	actual := NewVersionedBiMap[string, int](1)
+	actual.Insert("a", 1)
+
+	pinned := actual.Snapshot()
+	assert.Equal(t, uint64(1), pinned.Version())
+	actual.Insert("b", 2)
+	actual.DeleteByKey("a")
+
+	v, ok := pinned.GetByKey("a")
+	assert.True(t, ok, "The pinned view should not change")
+	assert.Equal(t, 1, v)
+	view, ok := actual.AtGeneration(1)
+	assert.True(t, ok, "Pinned generations should be retained past the window")
+	assert.Equal(t, pinned.ImmutableBiMap, view)
+
+	again, ok := actual.SnapshotAt(1)
+	assert.True(t, ok)
+	pinned.Release()
+	pinned.Release()
+	_, ok = actual.AtGeneration(1)
+	assert.True(t, ok, "A generation should stay retained while any snapshot pins it")
+
+	again.Release()
+	_, ok = actual.AtGeneration(1)
+	assert.False(t, ok, "Released generations outside the window should be dropped")
+	_, ok = actual.SnapshotAt(1)
+	assert.False(t, ok)
+	assert.Equal(t, 1, again.Size(), "Released views should stay readable")
+}
+
+func TestVersionedBiMap_SnapshotWithinWindow(t *testing.T) {
+	actual := NewVersionedBiMap[string, int](3)
+	actual.Insert("a", 1)
+	pinned := actual.Snapshot()
+	pinned.Release()
+
+	_, ok := actual.AtGeneration(1)
+	assert.True(t, ok, "Releasing should not drop generations within the window")
+	actual.Insert("b", 2)
+	actual.Insert("c", 3)
+	actual.Insert("d", 4)
+	_, ok = actual.AtGeneration(1)
+	assert.False(t, ok)
+}
@@ -0,0 +1,79 @@
+package bimap
+
+import (
+	"context"
+	"slices"
+)
+
+// WaitForKey returns the value for k, blocking until another goroutine inserts k if it is not yet present.
+// It returns ctx.Err() if ctx is done first, or ErrClosed if the BiMap is returned to a Pool. Keys are matched after
+// normalization and collation, like GetByKey.
+func (b *BiMap[K, V]) WaitForKey(ctx context.Context, k K) (V, error) {
+	b.lock()
+	k = b.resolveKey(k)
+	if v, ok := b.forward[k]; ok {
+		b.unlock()
+		return v, nil
+	}
+	ch := make(chan V, 1)
+	if b.waiters == nil {
+		b.waiters = make(map[K][]chan V)
+	}
+	b.waiters[k] = append(b.waiters[k], ch)
+	b.unlock()
+
+	select {
+	case v, ok := <-ch:
+		return waitResult(v, ok)
+	case <-ctx.Done():
+	}
+
+	b.lock()
+	defer b.unlock()
+	if i := slices.Index(b.waiters[k], ch); i >= 0 {
+		b.waiters[k] = slices.Delete(b.waiters[k], i, i+1)
+		if len(b.waiters[k]) == 0 {
+			delete(b.waiters, k)
+		}
+	}
+	select {
+	case v, ok := <-ch:
+		// The key arrived, or the BiMap was recycled, while the context was being cancelled.
+		return waitResult(v, ok)
+	default:
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// wakeWaiters hands the value to every WaitForKey caller whose key is present. The caller must hold the lock.
+func (b *BiMap[K, V]) wakeWaiters() {
+	for k, chans := range b.waiters {
+		v, ok := b.forward[b.resolveKey(k)]
+		if !ok {
+			continue
+		}
+		for _, ch := range chans {
+			ch <- v
+		}
+		delete(b.waiters, k)
+	}
+}
+
+// waitResult interprets a receive from a WaitForKey channel, which is closed if the BiMap is recycled.
+func waitResult[V any](v V, ok bool) (V, error) {
+	if !ok {
+		return v, ErrClosed
+	}
+	return v, nil
+}
+
+// closeWaiters releases every WaitForKey caller with ErrClosed. The caller must hold the lock.
+func (b *BiMap[K, V]) closeWaiters() {
+	for _, chans := range b.waiters {
+		for _, ch := range chans {
+			close(ch)
+		}
+	}
+	b.waiters = nil
+}
@@ -0,0 +1,91 @@
+package bimap
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+func TestBiMap_WaitForKey(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	actual.Insert("present", 1)
+
+	v, err := actual.WaitForKey(context.Background(), "present")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v, "Present keys should return immediately")
+
+	results := make(chan int, 2)
+	for range 2 {
+		go func() {
+			v, err := actual.WaitForKey(context.Background(), "later")
+			assert.NoError(t, err)
+			results <- v
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	actual.Insert("other", 3)
+	actual.Insert("later", 2)
+	assert.Equal(t, 2, <-results)
+	assert.Equal(t, 2, <-results)
+	assert.Empty(t, actual.waiters, "Satisfied waiters should be removed")
+}
+
+func TestBiMap_WaitForKey_Cancelled(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := actual.WaitForKey(ctx, "never")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Empty(t, actual.waiters, "Cancelled waiters should be removed")
+}
+
+func TestBiMap_WaitForKey_Recycled(t *testing.T) {
+	pool := NewPool[string, int]()
+	actual := pool.Get()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := actual.WaitForKey(context.Background(), "never")
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	pool.Put(actual)
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, ErrClosed)
+	case <-time.After(time.Second):
+		t.Fatal("Returning the BiMap to a pool should release WaitForKey callers")
+	}
+}
+
+func TestBiMap_WaitForKey_Collation(t *testing.T) {
+	actual := NewBiMap[string, int](WithCollator(language.English, collate.IgnoreCase))
+	done := make(chan int)
+	go func() {
+		v, _ := actual.WaitForKey(context.Background(), "key")
+		done <- v
+	}()
+	time.Sleep(10 * time.Millisecond)
+	actual.Insert("KEY", 1)
+	assert.Equal(t, 1, <-done)
+}
+
+func TestBiMap_WaitForKey_Restore(t *testing.T) {
+	actual := NewBiMap[string, int](WithSoftDelete())
+	actual.Insert("a", 1)
+	actual.DeleteByKey("a")
+	done := make(chan int)
+	go func() {
+		v, _ := actual.WaitForKey(context.Background(), "a")
+		done <- v
+	}()
+	time.Sleep(10 * time.Millisecond)
+	actual.Restore("a")
+	assert.Equal(t, 1, <-done)
+}
@@ -0,0 +1,142 @@
+package bimap
+
+import (
+	"context"
+	"slices"
+)
+
+// ChangeOp identifies the kind of change reported by a ChangeEvent.
+type ChangeOp int
+
+const (
+	// ChangeInsert reports a new key being added.
+	ChangeInsert ChangeOp = iota
+	// ChangeReplace reports an existing key being mapped to a new value.
+	ChangeReplace
+	// ChangeDelete reports a pair being removed.
+	ChangeDelete
+)
+
+// String returns the name of the operation.
+func (op ChangeOp) String() string {
+	switch op {
+	case ChangeInsert:
+		return "insert"
+	case ChangeReplace:
+		return "replace"
+	case ChangeDelete:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// ChangeEvent is a change to a BiMap delivered by Watch. OldValue is set for replacements and deletions, NewValue
+// for insertions and replacements.
+type ChangeEvent[K comparable, V comparable] struct {
+	Op       ChangeOp
+	Key      K
+	OldValue V
+	NewValue V
+	// Dropped is the number of events dropped for this watcher since the previous delivered event because its
+	// buffer was full. A non-zero value means the watcher missed changes and should resynchronize.
+	Dropped int
+}
+
+// WatchOption configures Watch.
+type WatchOption func(*watchConfig)
+
+type watchConfig struct {
+	buffer   int
+	blocking bool
+}
+
+// WithWatchBuffer sets how many events can be queued for a watcher, which defaults to 64.
+func WithWatchBuffer(n int) WatchOption {
+	return func(c *watchConfig) {
+		c.buffer = n
+	}
+}
+
+// WithWatchBlocking makes changes wait for a watcher with a full buffer instead of dropping its events. Writers to
+// the BiMap, which hold its lock while they wait, are then slowed to the pace of the slowest such watcher.
+func WithWatchBlocking() WatchOption {
+	return func(c *watchConfig) {
+		c.blocking = true
+	}
+}
+
+type watcher[K comparable, V comparable] struct {
+	ctx      context.Context
+	ch       chan ChangeEvent[K, V]
+	stopped  chan struct{}
+	blocking bool
+	dropped  int
+}
+
+// stop closes the watcher's channel if it is still open. The caller must hold the write lock.
+func (w *watcher[K, V]) stop() {
+	select {
+	case <-w.stopped:
+	default:
+		close(w.stopped)
+		close(w.ch)
+	}
+}
+
+// Watch returns a channel streaming every subsequent insertion, replacement and deletion, in the order they happen,
+// until ctx is done, when the channel is closed. By default a watcher whose buffer is full misses events, which is
+// reported by the Dropped field of the next event it receives; see WithWatchBlocking to apply backpressure instead.
+// Wholesale replacements, such as decoding, report every old pair as deleted and every new pair as inserted. The
+// channel is closed from another goroutine, so Watch must not be used on a BiMap built WithoutLocking.
+func (b *BiMap[K, V]) Watch(ctx context.Context, opts ...WatchOption) <-chan ChangeEvent[K, V] {
+	c := watchConfig{buffer: 64}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	w := &watcher[K, V]{
+		ctx:      ctx,
+		ch:       make(chan ChangeEvent[K, V], max(c.buffer, 0)),
+		stopped:  make(chan struct{}),
+		blocking: c.blocking,
+	}
+	b.lock()
+	b.listeners().watchers = append(b.listeners().watchers, w)
+	b.unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-w.stopped:
+			// The BiMap was reset and has already closed the channel.
+			return
+		}
+		b.lock()
+		defer b.unlock()
+		if b.hooks != nil {
+			b.hooks.watchers = slices.DeleteFunc(b.hooks.watchers, func(other *watcher[K, V]) bool { return other == w })
+		}
+		w.stop()
+	}()
+	return w.ch
+}
+
+// notifyWatchers delivers e to every watcher. The caller must hold the write lock.
+func (b *BiMap[K, V]) notifyWatchers(e ChangeEvent[K, V]) {
+	for _, w := range b.hooks.watchers {
+		e.Dropped = w.dropped
+		if w.blocking {
+			select {
+			case w.ch <- e:
+				w.dropped = 0
+			case <-w.ctx.Done():
+			}
+			continue
+		}
+		select {
+		case w.ch <- e:
+			w.dropped = 0
+		default:
+			w.dropped++
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package bimap
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap_Watch(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	events := actual.Watch(ctx)
+
+	actual.Insert("a", 1)
+	actual.Insert("a", 2)
+	actual.DeleteByValue(2)
+
+	assert.Equal(t, ChangeEvent[string, int]{Op: ChangeInsert, Key: "a", NewValue: 1}, <-events)
+	assert.Equal(t, ChangeEvent[string, int]{Op: ChangeReplace, Key: "a", OldValue: 1, NewValue: 2}, <-events)
+	assert.Equal(t, ChangeEvent[string, int]{Op: ChangeDelete, Key: "a", OldValue: 2}, <-events)
+
+	cancel()
+	_, open := <-events
+	assert.False(t, open, "The channel should be closed when the context is done")
+	actual.Insert("b", 3)
+	assert.Empty(t, actual.hooks.watchers, "Cancelled watchers should be removed")
+}
+
+func TestBiMap_WatchReset(t *testing.T) {
+	pool := NewPool[string, int]()
+	actual := pool.Get()
+	ctx, cancel := context.WithCancel(context.Background())
+	events := actual.Watch(ctx)
+
+	pool.Put(actual)
+	_, open := <-events
+	assert.False(t, open, "Returning the BiMap to a pool should close its watchers")
+
+	// Cancelling afterwards must not close the channel a second time, which would crash the watcher goroutine.
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+}
+
+func TestBiMap_WatchDrop(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := actual.Watch(ctx, WithWatchBuffer(1))
+
+	actual.Insert("a", 1)
+	actual.Insert("b", 2)
+	actual.Insert("c", 3)
+	assert.Equal(t, "a", (<-events).Key)
+
+	actual.Insert("d", 4)
+	e := <-events
+	assert.Equal(t, "d", e.Key)
+	assert.Equal(t, 2, e.Dropped, "Dropped events should be reported with the next delivered one")
+}
+
+func TestBiMap_WatchBlocking(t *testing.T) {
+	actual := NewBiMap[string, int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	events := actual.Watch(ctx, WithWatchBuffer(0), WithWatchBlocking())
+
+	done := make(chan struct{})
+	go func() {
+		actual.Insert("a", 1)
+		actual.Insert("b", 2)
+		close(done)
+	}()
+	assert.Equal(t, "a", (<-events).Key)
+	assert.Equal(t, "b", (<-events).Key)
+	<-done
+
+	go actual.Insert("c", 3)
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	for range events {
+	}
+	assert.Eventually(t, func() bool { return actual.ExistsByKey("c") }, time.Second, time.Millisecond,
+		"Cancelling should release a writer blocked on the watcher")
+}
+
+func TestChangeOp_String(t *testing.T) {
+	assert.Equal(t, "insert", ChangeInsert.String())
+	assert.Equal(t, "replace", ChangeReplace.String())
+	assert.Equal(t, "delete", ChangeDelete.String())
+	assert.Equal(t, "unknown", ChangeOp(9).String())
+}
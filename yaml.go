@@ -0,0 +1,52 @@
+package bimap
+
+// The YAML methods use the function-based Unmarshaler signature, which both gopkg.in/yaml.v2 and
+// gopkg.in/yaml.v3 honor, so the package does not depend on a YAML library.
+
+// MarshalYAML encodes the BiMap as a YAML mapping of its key-value pairs. Keys and values implementing
+// encoding.TextMarshaler are written in their text form.
+func (b *BiMap[K, V]) MarshalYAML() (any, error) {
+	return textForward(b.SnapshotForward())
+}
+
+// UnmarshalYAML decodes a YAML mapping into the BiMap, replacing its contents as UnmarshalJSON does.
+// Keys and values implementing encoding.TextUnmarshaler are read from their text form. It returns ErrDuplicateValue
+// if two keys map to the same value, ErrImmutable if the BiMap is immutable, or the first insert error, leaving the
+// BiMap unchanged.
+func (b *BiMap[K, V]) UnmarshalYAML(unmarshal func(any) error) error {
+	forward, err := unmarshalYAMLForward[K, V](unmarshal)
+	if err != nil {
+		return err
+	}
+	b.lock()
+	defer b.unlock()
+	if b.immutable {
+		return ErrImmutable
+	}
+	return b.replaceContents(forward)
+}
+
+// MarshalYAML encodes the ImmutableBiMap as a YAML mapping of its key-value pairs. Keys and values implementing
+// encoding.TextMarshaler are written in their text form.
+func (b *ImmutableBiMap[K, V]) MarshalYAML() (any, error) {
+	return textForward(b.forward)
+}
+
+// UnmarshalYAML decodes a YAML mapping into an empty ImmutableBiMap, such as a zero value being filled in by a YAML
+// decoder. Keys and values implementing encoding.TextUnmarshaler are read from their text form. It returns
+// ErrImmutable if the ImmutableBiMap already holds pairs and ErrDuplicateValue if two keys map to the same value.
+func (b *ImmutableBiMap[K, V]) UnmarshalYAML(unmarshal func(any) error) error {
+	if err := b.checkDecodable(); err != nil {
+		return err
+	}
+	forward, err := unmarshalYAMLForward[K, V](unmarshal)
+	if err != nil {
+		return err
+	}
+	inverse, err := invert(forward)
+	if err != nil {
+		return err
+	}
+	b.forward, b.inverse = forward, inverse
+	return nil
+}
@@ -0,0 +1,65 @@
+package bimap
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestBiMap_YAML(t *testing.T) {
+	m := NewBiMapFromMap(map[string]int{"a": 1, "b": 2})
+
+	data, err := yaml.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, "a: 1\nb: 2\n", string(data))
+
+	var config struct {
+		Codes *BiMap[string, int] `yaml:"codes"`
+	}
+	assert.NoError(t, yaml.Unmarshal([]byte("codes:\n  a: 1\n  b: 2\n"), &config))
+	k, ok := config.Codes.GetByValue(2)
+	assert.True(t, ok)
+	assert.Equal(t, "b", k)
+}
+
+func TestBiMap_YAMLDuplicateValue(t *testing.T) {
+	decoded := NewBiMapFromMap(map[string]int{"x": 7})
+	err := yaml.Unmarshal([]byte("a: 1\nb: 1\n"), decoded)
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+	assert.Equal(t, map[string]int{"x": 7}, decoded.SnapshotForward(), "Failed decode should leave the BiMap unchanged")
+}
+
+func TestBiMap_YAMLOptions(t *testing.T) {
+	decoded := NewBiMap[string, int](WithValueValidator(func(v int) error {
+		if v < 0 {
+			return errors.New("negative")
+		}
+		return nil
+	}))
+	assert.ErrorIs(t, yaml.Unmarshal([]byte("a: 1\nb: -1\n"), decoded), ErrInvalidValue)
+	assert.Equal(t, 0, decoded.Size(), "A rejected decode should leave the BiMap unchanged")
+
+	assert.NoError(t, yaml.Unmarshal([]byte("a: 1\n"), decoded))
+	decoded.MakeImmutable()
+	assert.ErrorIs(t, yaml.Unmarshal([]byte("b: 2\n"), decoded), ErrImmutable)
+}
+
+func TestImmutableBiMap_YAML(t *testing.T) {
+	m := NewImmutableBiMapFromMap(map[int]string{1: "one", 2: "two"})
+
+	data, err := yaml.Marshal(m)
+	assert.NoError(t, err)
+
+	var decoded ImmutableBiMap[int, string]
+	assert.NoError(t, yaml.Unmarshal(data, &decoded))
+	assert.Equal(t, m.GetForwardMap(), decoded.GetForwardMap())
+	assert.Equal(t, m.GetInverseMap(), decoded.GetInverseMap())
+
+	assert.ErrorIs(t, yaml.Unmarshal([]byte("1: x\n"), &decoded), ErrImmutable, "A populated ImmutableBiMap should not be overwritten")
+	assert.Equal(t, m.GetForwardMap(), decoded.GetForwardMap())
+
+	var duplicate ImmutableBiMap[int, string]
+	assert.ErrorIs(t, yaml.Unmarshal([]byte("1: x\n2: x\n"), &duplicate), ErrDuplicateValue)
+}